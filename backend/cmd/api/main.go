@@ -26,6 +26,10 @@ import (
 // @securityDefinitions.apikey BearerAuth
 // @in header
 // @name Authorization
+
+// @securityDefinitions.apikey APIKeyAuth
+// @in header
+// @name X-API-Key
 func main() {
 	// Carregar variáveis de ambiente
 	if err := godotenv.Load(); err != nil {
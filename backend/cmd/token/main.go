@@ -0,0 +1,85 @@
+package main
+
+import (
+	"catalogo-produtos/backend/db"
+	"catalogo-produtos/backend/internal/config"
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	infraAuth "catalogo-produtos/backend/internal/infrastructure/auth"
+	infraRepos "catalogo-produtos/backend/internal/infrastructure/repositories"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// token emite um par de tokens (acesso e refresh) para um subject, para integrações e
+// operadores que ainda não têm como fazer login pela API.
+//
+//	go run ./cmd/token -sub erp-integracao -roles editor
+//	go run ./cmd/token -sub maria@empresa.com -roles admin -claims '{"name": "Maria"}'
+func main() {
+	subject := flag.String("sub", "", "subject do token (obrigatório)")
+	rawRoles := flag.String("roles", "", "papéis separados por vírgula (viewer, editor, admin)")
+	rawClaims := flag.String("claims", "", "claims adicionais, em JSON")
+	flag.Parse()
+
+	if *subject == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var claims map[string]interface{}
+	if *rawClaims != "" {
+		if err := json.Unmarshal([]byte(*rawClaims), &claims); err != nil {
+			log.Fatal("Claims inválidas: ", err)
+		}
+	}
+	if *rawRoles != "" {
+		roles := []string{}
+		for _, name := range strings.Split(*rawRoles, ",") {
+			role := entities.Role(strings.TrimSpace(name))
+			if !role.IsValid() {
+				log.Fatalf("Papel inválido: %q", role)
+			}
+			roles = append(roles, string(role))
+		}
+		if claims == nil {
+			claims = map[string]interface{}{}
+		}
+		claims[entities.RolesClaim] = roles
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("Arquivo .env não encontrado, usando variáveis de ambiente do sistema")
+	}
+	cfg := config.Load()
+
+	signer, err := infraAuth.NewJWTSigner(cfg.Auth)
+	if err != nil {
+		log.Fatal("Erro na configuração de autenticação: ", err)
+	}
+
+	database := db.NewDatabase(&cfg.Database)
+	defer database.Close()
+
+	authUseCase := usecases.NewAuthUseCase(signer, infraRepos.NewRefreshTokenRepository(database.DB), infraRepos.NewUserRepository(database.DB), cfg.Auth.RefreshTokenTTL)
+	tokens, err := authUseCase.IssueTokens(*subject, claims)
+	if err != nil {
+		log.Fatal("Erro ao emitir tokens: ", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(map[string]interface{}{
+		"access_token":       tokens.AccessToken,
+		"token_type":         "Bearer",
+		"expires_at":         tokens.AccessExpiresAt.Format(time.RFC3339),
+		"refresh_token":      tokens.RefreshToken,
+		"refresh_expires_at": tokens.RefreshExpiresAt.Format(time.RFC3339),
+	})
+}
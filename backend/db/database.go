@@ -36,12 +36,24 @@ func NewDatabase(config *config.DatabaseConfig) *Database {
 	// Auto-migrar tabelas
 	err = db.AutoMigrate(
 		&models.CategoryModel{},
+		&models.CategoryAttributeModel{},
 		&models.ProductModel{},
+		&models.ProductOptionModel{},
+		&models.ProductVariantModel{},
+		&models.StockMovementModel{},
+		&models.RefreshTokenModel{},
+		&models.UserModel{},
+		&models.APIKeyModel{},
 	)
 	if err != nil {
 		log.Fatal("Erro ao migrar tabelas:", err)
 	}
 
+	// Aplicar migrações SQL (índices, extensões e colunas gerenciadas pelo banco)
+	if err := Migrate(db); err != nil {
+		log.Fatal("Erro ao aplicar migrações:", err)
+	}
+
 	log.Println("Banco de dados conectado e migrado com sucesso")
 
 	return &Database{DB: db}
@@ -0,0 +1,271 @@
+package db
+
+import (
+	"log"
+
+	"gorm.io/gorm"
+)
+
+// migration representa uma alteração de schema que não pode ser expressa pelo AutoMigrate
+type migration struct {
+	Version string
+	SQL     string
+}
+
+// schemaMigration registra as migrações já aplicadas
+type schemaMigration struct {
+	Version string `gorm:"primaryKey;size:100"`
+}
+
+// TableName especifica o nome da tabela
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// migrations lista as migrações SQL na ordem em que devem ser aplicadas
+var migrations = []migration{
+	{
+		// Busca textual em português, insensível a acentos, sobre nome e descrição.
+		// A coluna gerada mantém o tsvector sempre sincronizado com o produto.
+		Version: "20240101000001_product_full_text_search",
+		SQL: `
+CREATE EXTENSION IF NOT EXISTS unaccent;
+
+DO $$
+BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'portuguese_unaccent') THEN
+		CREATE TEXT SEARCH CONFIGURATION portuguese_unaccent (COPY = portuguese);
+		ALTER TEXT SEARCH CONFIGURATION portuguese_unaccent
+			ALTER MAPPING FOR hword, hword_part, word WITH unaccent, portuguese_stem;
+	END IF;
+END
+$$;
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS search_vector tsvector
+	GENERATED ALWAYS AS (
+		setweight(to_tsvector('portuguese_unaccent'::regconfig, coalesce(name, '')), 'A') ||
+		setweight(to_tsvector('portuguese_unaccent'::regconfig, coalesce(description, '')), 'B')
+	) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_products_search_vector ON products USING GIN (search_vector);
+`,
+	},
+	{
+		// Slugs para produtos existentes e unicidade de SKU e slug entre produtos ativos
+		Version: "20240101000002_product_sku_slug",
+		SQL: `
+UPDATE products
+SET slug = trim(both '-' from lower(regexp_replace(unaccent(name), '[^a-zA-Z0-9]+', '-', 'g')))
+WHERE slug IS NULL OR slug = '';
+
+UPDATE products p
+SET slug = p.slug || '-' || p.id
+WHERE p.deleted_at IS NULL AND EXISTS (
+	SELECT 1 FROM products o
+	WHERE o.slug = p.slug AND o.id < p.id AND o.deleted_at IS NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_sku ON products (sku) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_slug ON products (slug) WHERE deleted_at IS NULL;
+`,
+	},
+	{
+		// Estoque nunca negativo e livro-razão de movimentações somente inserção
+		Version: "20240101000003_stock_ledger",
+		SQL: `
+ALTER TABLE products DROP CONSTRAINT IF EXISTS chk_products_stock_non_negative;
+ALTER TABLE products ADD CONSTRAINT chk_products_stock_non_negative CHECK (stock_quantity >= 0);
+
+CREATE OR REPLACE FUNCTION prevent_stock_movement_changes() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'stock_movements é somente inserção';
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_stock_movements_append_only ON stock_movements;
+CREATE TRIGGER trg_stock_movements_append_only
+	BEFORE UPDATE OR DELETE ON stock_movements
+	FOR EACH ROW EXECUTE FUNCTION prevent_stock_movement_changes();
+`,
+	},
+	{
+		// Variantes: estoque não negativo, SKU único e uma variante por combinação de opções
+		Version: "20240101000004_product_variants",
+		SQL: `
+ALTER TABLE product_variants DROP CONSTRAINT IF EXISTS chk_product_variants_stock_non_negative;
+ALTER TABLE product_variants ADD CONSTRAINT chk_product_variants_stock_non_negative CHECK (stock_quantity >= 0);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_variants_sku ON product_variants (sku) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_variants_option_key
+	ON product_variants (product_id, option_key) WHERE deleted_at IS NULL;
+`,
+	},
+	{
+		// Atributos tipados: produtos existentes começam sem especificações
+		Version: "20240101000005_product_attributes",
+		SQL: `
+UPDATE products SET attributes = '{}'::jsonb WHERE attributes IS NULL;
+ALTER TABLE products ALTER COLUMN attributes SET DEFAULT '{}'::jsonb;
+`,
+	},
+	{
+		// Controle de concorrência otimista: toda alteração incrementa a versão do registro.
+		// Opções e variantes fazem parte da representação do produto e também a incrementam.
+		Version: "20240101000006_resource_versions",
+		SQL: `
+CREATE OR REPLACE FUNCTION increment_version() RETURNS trigger AS $$
+BEGIN
+	NEW.version := OLD.version + 1;
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_products_version ON products;
+CREATE TRIGGER trg_products_version
+	BEFORE UPDATE ON products
+	FOR EACH ROW EXECUTE FUNCTION increment_version();
+
+DROP TRIGGER IF EXISTS trg_categories_version ON categories;
+CREATE TRIGGER trg_categories_version
+	BEFORE UPDATE ON categories
+	FOR EACH ROW EXECUTE FUNCTION increment_version();
+
+CREATE OR REPLACE FUNCTION increment_product_version() RETURNS trigger AS $$
+BEGIN
+	IF TG_OP = 'DELETE' THEN
+		UPDATE products SET version = version WHERE id = OLD.product_id;
+		RETURN OLD;
+	END IF;
+	UPDATE products SET version = version WHERE id = NEW.product_id;
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_product_variants_version ON product_variants;
+CREATE TRIGGER trg_product_variants_version
+	AFTER INSERT OR UPDATE OR DELETE ON product_variants
+	FOR EACH ROW EXECUTE FUNCTION increment_product_version();
+
+DROP TRIGGER IF EXISTS trg_product_options_version ON product_options;
+CREATE TRIGGER trg_product_options_version
+	AFTER INSERT OR UPDATE OR DELETE ON product_options
+	FOR EACH ROW EXECUTE FUNCTION increment_product_version();
+`,
+	},
+	{
+		// Movimentações de estoque não alteram a versão: o saldo muda a todo momento e não faz
+		// parte do que o cliente edita. Opções e variantes passam a incrementar a versão
+		// explicitamente, já que o gatilho de produtos ignora atualizações sem alteração.
+		Version: "20240101000007_stock_outside_version",
+		SQL: `
+CREATE OR REPLACE FUNCTION increment_product_row_version() RETURNS trigger AS $$
+BEGIN
+	IF NEW.version = OLD.version
+		AND to_jsonb(NEW) - ARRAY['stock_quantity', 'updated_at', 'search_vector']
+			= to_jsonb(OLD) - ARRAY['stock_quantity', 'updated_at', 'search_vector'] THEN
+		RETURN NEW;
+	END IF;
+	NEW.version := OLD.version + 1;
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_products_version ON products;
+CREATE TRIGGER trg_products_version
+	BEFORE UPDATE ON products
+	FOR EACH ROW EXECUTE FUNCTION increment_product_row_version();
+
+CREATE OR REPLACE FUNCTION increment_product_version() RETURNS trigger AS $$
+BEGIN
+	IF TG_OP = 'DELETE' THEN
+		UPDATE products SET version = version + 1 WHERE id = OLD.product_id;
+		RETURN OLD;
+	END IF;
+	IF TG_OP = 'UPDATE'
+		AND to_jsonb(NEW) - ARRAY['stock_quantity', 'updated_at']
+			= to_jsonb(OLD) - ARRAY['stock_quantity', 'updated_at'] THEN
+		RETURN NEW;
+	END IF;
+	UPDATE products SET version = version + 1 WHERE id = NEW.product_id;
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+`,
+	},
+	{
+		// SKU obrigatório e único entre produtos e variantes. Produtos antigos sem SKU recebem
+		// PRD-<id>. O gatilho serializa, por SKU, as escritas nas duas tabelas e recusa um SKU já
+		// usado na outra com unique_violation, como os índices únicos de cada tabela.
+		Version: "20240101000008_product_sku_required",
+		SQL: `
+UPDATE products p
+SET sku = 'PRD-' || p.id
+WHERE (p.sku IS NULL OR p.sku = '')
+	AND NOT EXISTS (SELECT 1 FROM products o WHERE o.sku = 'PRD-' || p.id)
+	AND NOT EXISTS (SELECT 1 FROM product_variants v WHERE v.sku = 'PRD-' || p.id);
+
+UPDATE products
+SET sku = 'PRD-' || id || '-' || substr(md5(random()::text), 1, 6)
+WHERE sku IS NULL OR sku = '';
+
+CREATE OR REPLACE FUNCTION check_shared_sku() RETURNS trigger AS $$
+BEGIN
+	IF NEW.sku IS NULL OR NEW.deleted_at IS NOT NULL THEN
+		RETURN NEW;
+	END IF;
+	PERFORM pg_advisory_xact_lock(hashtext('sku:' || NEW.sku));
+
+	IF (TG_TABLE_NAME = 'products' AND EXISTS (
+			SELECT 1 FROM product_variants WHERE sku = NEW.sku AND deleted_at IS NULL))
+		OR (TG_TABLE_NAME = 'product_variants' AND EXISTS (
+			SELECT 1 FROM products WHERE sku = NEW.sku AND deleted_at IS NULL)) THEN
+		RAISE EXCEPTION 'SKU % já está em uso', NEW.sku
+			USING ERRCODE = 'unique_violation', CONSTRAINT = 'products_variants_sku';
+	END IF;
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_products_shared_sku ON products;
+CREATE TRIGGER trg_products_shared_sku
+	BEFORE INSERT OR UPDATE OF sku, deleted_at ON products
+	FOR EACH ROW EXECUTE FUNCTION check_shared_sku();
+
+DROP TRIGGER IF EXISTS trg_product_variants_shared_sku ON product_variants;
+CREATE TRIGGER trg_product_variants_shared_sku
+	BEFORE INSERT OR UPDATE OF sku, deleted_at ON product_variants
+	FOR EACH ROW EXECUTE FUNCTION check_shared_sku();
+`,
+	},
+}
+
+// Migrate aplica as migrações SQL pendentes, cada uma em sua própria transação
+func Migrate(db *gorm.DB) error {
+	if err := db.AutoMigrate(&schemaMigration{}); err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		var count int64
+		if err := db.Model(&schemaMigration{}).Where("version = ?", m.Version).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+
+		err := db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(m.SQL).Error; err != nil {
+				return err
+			}
+			return tx.Create(&schemaMigration{Version: m.Version}).Error
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Printf("Migração aplicada: %s", m.Version)
+	}
+
+	return nil
+}
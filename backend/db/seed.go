@@ -44,66 +44,91 @@ func Seed(db *gorm.DB) {
 	// Criar produtos
 	products := []models.ProductModel{
 		{
+			SKU:         sku("ELE-SMART-001"),
+			Slug:        "smartphone-galaxy-s23",
 			Name:        "Smartphone Galaxy S23",
 			Image:       "https://images.unsplash.com/photo-1511707171634-5f897ff02aa9?w=400",
-			Price:       2999.99,
+			Price:       "2999.99",
+			Currency:    "BRL",
 			CategoryID:  eletronicos.ID,
 			Description: "Smartphone com tela de 6.4 polegadas, câmera tripla de 50MP e bateria de 5000mAh",
 		},
 		{
+			SKU:         sku("ELE-NOTE-001"),
+			Slug:        "notebook-dell-inspiron",
 			Name:        "Notebook Dell Inspiron",
 			Image:       "https://images.unsplash.com/photo-1496181133206-80ce9b88a853?w=400",
-			Price:       4599.99,
+			Price:       "4599.99",
+			Currency:    "BRL",
 			CategoryID:  eletronicos.ID,
 			Description: "Notebook com Intel Core i5, 8GB RAM, SSD 256GB e tela Full HD de 15.6 polegadas",
 		},
 		{
+			SKU:         sku("ROU-CAM-001"),
+			Slug:        "camiseta-basica",
 			Name:        "Camiseta Básica",
 			Image:       "https://images.unsplash.com/photo-1521572163474-6864f9cf17ab?w=400",
-			Price:       49.99,
+			Price:       "49.99",
+			Currency:    "BRL",
 			CategoryID:  roupas.ID,
 			Description: "Camiseta 100% algodão com corte moderno e tecido de alta qualidade",
 		},
 		{
+			SKU:         sku("ROU-CAL-001"),
+			Slug:        "calca-jeans",
 			Name:        "Calça Jeans",
 			Image:       "https://images.unsplash.com/photo-1542272604-787c3835535d?w=400",
-			Price:       129.99,
+			Price:       "129.99",
+			Currency:    "BRL",
 			CategoryID:  roupas.ID,
 			Description: "Calça jeans tradicional com corte clássico e acabamentos de qualidade",
 		},
 		{
+			SKU:         sku("LIV-SDA-001"),
+			Slug:        "o-senhor-dos-aneis",
 			Name:        "O Senhor dos Anéis",
 			Image:       "https://images.unsplash.com/photo-1544947950-fa07a98d237f?w=400",
-			Price:       89.99,
+			Price:       "89.99",
+			Currency:    "BRL",
 			CategoryID:  livros.ID,
 			Description: "Livro clássico de fantasia escrito por J.R.R. Tolkien, edição especial ilustrada",
 		},
 		{
+			SKU:         sku("CAS-VAS-001"),
+			Slug:        "vaso-decorativo",
 			Name:        "Vaso Decorativo",
 			Image:       "https://images.unsplash.com/photo-1485955900006-10f4d324d411?w=400",
-			Price:       79.99,
+			Price:       "79.99",
+			Currency:    "BRL",
 			CategoryID:  casa.ID,
 			Description: "Vaso decorativo em cerâmica para plantas, ideal para ambientes internos",
 		},
 		{
+			SKU:         sku("ESP-BOL-001"),
+			Slug:        "bola-de-futebol",
 			Name:        "Bola de Futebol",
 			Image:       "https://images.unsplash.com/photo-1552318965-6e6be7484ada?w=400",
-			Price:       89.99,
+			Price:       "89.99",
+			Currency:    "BRL",
 			CategoryID:  esportes.ID,
 			Description: "Bola de futebol oficial tamanho 5, costurada à mão, ideal para jogos e treinos",
 		},
 		{
+			SKU:         sku("ESP-TEN-001"),
+			Slug:        "tenis-nike",
 			Name:        "Tênis Nike",
 			Image:       "https://images.unsplash.com/photo-1542291026-7eec264c27ff?w=400",
-			Price:       299.99,
+			Price:       "299.99",
+			Currency:    "BRL",
 			CategoryID:  esportes.ID,
 			Description: "Tênis esportivo Nike com tecnologia Air Max para máximo conforto e performance",
 		},
 	}
 
 	for _, product := range products {
+		// Produtos são identificados pelo SKU; o nome cobre bancos populados antes da criação do SKU
 		var existingProduct models.ProductModel
-		if err := db.Where("name = ?", product.Name).First(&existingProduct).Error; err != nil {
+		if err := db.Where("sku = ? OR name = ?", *product.SKU, product.Name).First(&existingProduct).Error; err != nil {
 			if err == gorm.ErrRecordNotFound {
 				if err := db.Create(&product).Error; err != nil {
 					log.Printf("Erro ao criar produto %s: %v", product.Name, err)
@@ -111,8 +136,20 @@ func Seed(db *gorm.DB) {
 					log.Printf("Produto criado: %s", product.Name)
 				}
 			}
+			continue
+		}
+
+		if existingProduct.SKU == nil {
+			if err := db.Model(&existingProduct).Update("sku", *product.SKU).Error; err != nil {
+				log.Printf("Erro ao atribuir SKU ao produto %s: %v", product.Name, err)
+			}
 		}
 	}
 
 	log.Println("Seed concluído!")
 }
+
+// sku retorna um ponteiro para o SKU informado
+func sku(value string) *string {
+	return &value
+}
@@ -4,15 +4,21 @@ import (
 	"catalogo-produtos/backend/db"
 	"catalogo-produtos/backend/docs"
 	"catalogo-produtos/backend/internal/config"
+	"catalogo-produtos/backend/internal/domain/entities"
 	"catalogo-produtos/backend/internal/domain/usecases"
+	infraAuth "catalogo-produtos/backend/internal/infrastructure/auth"
 	infraRepos "catalogo-produtos/backend/internal/infrastructure/repositories"
+	"catalogo-produtos/backend/internal/presentation/graphql"
+	"catalogo-produtos/backend/internal/presentation/grpcserver"
 	"catalogo-produtos/backend/internal/presentation/handlers"
+	"catalogo-produtos/backend/internal/presentation/middleware"
 	"log"
+	"net"
 
-	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"google.golang.org/grpc"
 )
 
 // App representa a aplicação principal
@@ -20,6 +26,8 @@ type App struct {
 	config *config.Config
 	router *gin.Engine
 	db     *db.Database
+	// grpcServer atende os consumidores internos, ao lado da API HTTP
+	grpcServer *grpc.Server
 }
 
 // NewApp cria uma nova instância da aplicação
@@ -42,61 +50,116 @@ func (a *App) Initialize() error {
 	// Executar seed
 	db.Seed(database.DB)
 
-	// Configurar CORS
-	a.setupCORS()
+	// Configurar cabeçalhos de segurança e CORS
+	if err := a.setupSecurityHeaders(); err != nil {
+		return err
+	}
+	if err := a.setupCORS(); err != nil {
+		return err
+	}
+
+	// Converter os erros registrados pelos handlers em respostas application/problem+json
+	a.router.Use(middleware.ErrorHandler())
+	a.router.NoRoute(func(c *gin.Context) {
+		c.Error(usecases.NewNotFoundError("route_not_found", "Rota não encontrada"))
+	})
 
 	// Configurar rotas
-	a.setupRoutes()
+	return a.setupRoutes()
+}
 
+// setupSecurityHeaders configura os cabeçalhos de segurança predefinidos para o ambiente
+func (a *App) setupSecurityHeaders() error {
+	policy, err := middleware.SecurityPreset(a.config.Security.Environment)
+	if err != nil {
+		return err
+	}
+	if a.config.Security.HSTSMaxAge > 0 {
+		policy.HSTSMaxAge = a.config.Security.HSTSMaxAge
+	}
+	a.router.Use(middleware.SecurityHeaders(policy, "/swagger/"))
 	return nil
 }
 
-// setupCORS configura o CORS
-func (a *App) setupCORS() {
-	config := cors.DefaultConfig()
-	config.AllowAllOrigins = true
-	config.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"}
-	config.AllowHeaders = []string{"Origin", "Content-Type", "Accept", "Authorization"}
-	a.router.Use(cors.New(config))
+// setupCORS configura o CORS com as origens permitidas
+func (a *App) setupCORS() error {
+	corsMiddleware, err := middleware.CORS(a.config.CORS)
+	if err != nil {
+		return err
+	}
+	a.router.Use(corsMiddleware)
+	return nil
 }
 
 // setupRoutes configura as rotas da aplicação
-func (a *App) setupRoutes() {
+func (a *App) setupRoutes() error {
 	// Configurar repositórios (Infrastructure Layer)
 	productRepo := infraRepos.NewProductRepository(a.db.DB)
 	categoryRepo := infraRepos.NewCategoryRepository(a.db.DB)
+	stockRepo := infraRepos.NewStockRepository(a.db.DB)
+	variantRepo := infraRepos.NewVariantRepository(a.db.DB)
+	attributeRepo := infraRepos.NewAttributeRepository(a.db.DB)
+	refreshTokenRepo := infraRepos.NewRefreshTokenRepository(a.db.DB)
+	userRepo := infraRepos.NewUserRepository(a.db.DB)
+	apiKeyRepo := infraRepos.NewAPIKeyRepository(a.db.DB)
+	transactor := infraRepos.NewTransactor(a.db.DB)
 
-	// Configurar casos de uso (Domain Layer)
-	productUseCase := usecases.NewProductUseCase(productRepo, categoryRepo)
-	categoryUseCase := usecases.NewCategoryUseCase(categoryRepo)
+	// Configurar casos de uso (Domain Layer). As escritas são autorizadas pelos papéis do token.
+	authorizer := usecases.NewRoleAuthorizer()
+	productUseCase := usecases.NewProductUseCase(productRepo, categoryRepo, attributeRepo, transactor, authorizer)
+	categoryUseCase := usecases.NewCategoryUseCase(categoryRepo, attributeRepo, authorizer)
+	stockUseCase := usecases.NewStockUseCase(stockRepo, productRepo, variantRepo, authorizer)
+	variantUseCase := usecases.NewVariantUseCase(variantRepo, productRepo, authorizer)
+	importUseCase := usecases.NewImportUseCase(transactor, authorizer)
+
+	// Configurar autenticação: a assinatura dos tokens depende das chaves configuradas
+	tokenSigner, err := infraAuth.NewJWTSigner(a.config.Auth)
+	if err != nil {
+		return err
+	}
+	authUseCase := usecases.NewAuthUseCase(tokenSigner, refreshTokenRepo, userRepo, a.config.Auth.RefreshTokenTTL)
+	userUseCase := usecases.NewUserUseCase(userRepo, infraAuth.NewBcryptHasher(a.config.Auth.PasswordHashCost), authUseCase, authorizer)
+	apiKeyUseCase := usecases.NewAPIKeyUseCase(apiKeyRepo, authorizer)
+	// Requisições se autenticam com um token de acesso ou com uma chave de API
+	authenticator := middleware.NewAuthenticator(authUseCase, apiKeyUseCase)
 
 	// Configurar handlers (Presentation Layer)
-	productHandler := handlers.NewProductHandler(productUseCase)
-	categoryHandler := handlers.NewCategoryHandler(categoryUseCase)
+	h := routeHandlers{
+		product:    handlers.NewProductHandler(productUseCase),
+		productV2:  handlers.NewProductV2Handler(productUseCase),
+		category:   handlers.NewCategoryHandler(categoryUseCase),
+		categoryV2: handlers.NewCategoryV2Handler(categoryUseCase),
+		stock:      handlers.NewStockHandler(stockUseCase),
+		variant:    handlers.NewVariantHandler(variantUseCase, productUseCase),
+		imports:    handlers.NewImportHandler(importUseCase),
+		exports:    handlers.NewExportHandler(productUseCase),
+		feed:       handlers.NewFeedHandler(productUseCase, categoryUseCase, a.config.Feed),
+		auth:       handlers.NewAuthHandler(authUseCase, userUseCase),
+		user:       handlers.NewUserHandler(userUseCase),
+		apiKey:     handlers.NewAPIKeyHandler(apiKeyUseCase),
 
-	// Rotas da API
-	api := a.router.Group("/api")
-	{
-		// Rotas de produtos
-		products := api.Group("/products")
-		{
-			products.GET("", productHandler.GetProducts)
-			products.GET("/:id", productHandler.GetProduct)
-			products.POST("", productHandler.CreateProduct)
-			products.PUT("/:id", productHandler.UpdateProduct)
-			products.DELETE("/:id", productHandler.DeleteProduct)
-		}
+		require:       authenticator.RequirePermission(authorizer),
+		authenticated: authenticator.Authenticate(),
+	}
 
-		// Rotas de categorias
-		categories := api.Group("/categories")
-		{
-			categories.GET("", categoryHandler.GetCategories)
-			categories.GET("/:id", categoryHandler.GetCategory)
-			categories.POST("", categoryHandler.CreateCategory)
-			categories.PUT("/:id", categoryHandler.UpdateCategory)
-			categories.DELETE("/:id", categoryHandler.DeleteCategory)
-		}
+	// A v1 responde em /api/v1 e, para os clientes anteriores ao versionamento, também em /api,
+	// com os erros no formato original. Rotas da v1 que já têm equivalente na v2 respondem com
+	// os cabeçalhos de descontinuação.
+	policy := middleware.DeprecationPolicy{
+		Since:  a.config.API.V1DeprecatedAt,
+		Sunset: a.config.API.V1Sunset,
 	}
+	a.setupV1Routes(a.router.Group("/api/v1", middleware.LegacyErrors()), h, middleware.Deprecated(policy, "/api/v1", "/api/v2"))
+	a.setupV1Routes(a.router.Group("/api", middleware.LegacyErrors()), h, middleware.Deprecated(policy, "/api", "/api/v2"))
+	a.setupV2Routes(a.router.Group("/api/v2"), h)
+
+	// GraphQL, sobre os mesmos casos de uso. Consultas são públicas; as permissões das
+	// mutações são verificadas pelos casos de uso
+	graphqlHandler := graphql.NewHandler(productUseCase, categoryUseCase)
+	a.router.POST("/graphql", authenticator.OptionalAuthentication(), graphqlHandler.Serve)
+
+	// gRPC, sobre os mesmos casos de uso, iniciado em Run
+	a.grpcServer = grpcserver.NewServer(productUseCase, categoryUseCase, authUseCase, apiKeyUseCase)
 
 	// Swagger
 	docs.SwaggerInfo.Title = "Catálogo de Produtos API"
@@ -114,12 +177,170 @@ func (a *App) setupRoutes() {
 			"message": "API está funcionando",
 		})
 	})
+
+	return nil
+}
+
+// routeHandlers reúne os handlers registrados nas rotas das versões da API
+type routeHandlers struct {
+	product    *handlers.ProductHandler
+	productV2  *handlers.ProductV2Handler
+	category   *handlers.CategoryHandler
+	categoryV2 *handlers.CategoryV2Handler
+	stock      *handlers.StockHandler
+	variant    *handlers.VariantHandler
+	imports    *handlers.ImportHandler
+	exports    *handlers.ExportHandler
+	feed       *handlers.FeedHandler
+	auth       *handlers.AuthHandler
+	user       *handlers.UserHandler
+	apiKey     *handlers.APIKeyHandler
+
+	// require declara a permissão exigida por uma rota de escrita
+	require func(permission entities.Permission) gin.HandlerFunc
+	// authenticated exige apenas um token de acesso válido
+	authenticated gin.HandlerFunc
 }
 
-// Run inicia o servidor
+// setupV1Routes registra as rotas da v1, congelada nos DTOs originais. deprecated é aplicado
+// às rotas que já têm equivalente na v2.
+func (a *App) setupV1Routes(api *gin.RouterGroup, h routeHandlers, deprecated gin.HandlerFunc) {
+	// Rotas de produtos
+	products := api.Group("/products")
+	{
+		products.GET("", deprecated, h.product.GetProducts)
+		products.POST("/bulk", h.require(entities.PermissionProductsBulk), h.product.BulkProducts)
+		products.GET("/by-sku/:sku", deprecated, h.product.GetProductBySKU)
+		products.GET("/by-slug/:slug", deprecated, h.product.GetProductBySlug)
+		products.GET("/:id", deprecated, h.product.GetProduct)
+		products.POST("", deprecated, h.require(entities.PermissionProductsCreate), h.product.CreateProduct)
+		products.PUT("/:id", deprecated, h.require(entities.PermissionProductsUpdate), h.product.UpdateProduct)
+		products.PATCH("/:id", deprecated, h.require(entities.PermissionProductsUpdate), h.product.PatchProduct)
+		products.DELETE("/:id", deprecated, h.require(entities.PermissionProductsDelete), h.product.DeleteProduct)
+
+		// Estoque
+		products.GET("/:id/stock/movements", h.stock.GetMovements)
+		products.POST("/:id/stock/movements", h.require(entities.PermissionStockWrite), h.stock.CreateMovement)
+
+		// Opções e variantes
+		products.GET("/:id/options", h.variant.GetOptions)
+		products.PUT("/:id/options", h.require(entities.PermissionProductsUpdate), h.variant.SetOptions)
+		products.GET("/:id/variants", h.variant.GetVariants)
+		products.GET("/:id/variants/:variantId", h.variant.GetVariant)
+		products.POST("/:id/variants", h.require(entities.PermissionProductsUpdate), h.variant.CreateVariant)
+		products.PUT("/:id/variants/:variantId", h.require(entities.PermissionProductsUpdate), h.variant.UpdateVariant)
+		products.DELETE("/:id/variants/:variantId", h.require(entities.PermissionProductsUpdate), h.variant.DeleteVariant)
+	}
+
+	// Rotas de categorias
+	categories := api.Group("/categories", deprecated)
+	{
+		categories.GET("", h.category.GetCategories)
+		categories.GET("/tree", h.category.GetCategoryTree)
+		categories.GET("/:id", h.category.GetCategory)
+		categories.POST("", h.require(entities.PermissionCategoriesCreate), h.category.CreateCategory)
+		categories.PUT("/:id", h.require(entities.PermissionCategoriesUpdate), h.category.UpdateCategory)
+		categories.PATCH("/:id", h.require(entities.PermissionCategoriesUpdate), h.category.PatchCategory)
+		categories.DELETE("/:id", h.require(entities.PermissionCategoriesDelete), h.category.DeleteCategory)
+		categories.GET("/:id/attributes", h.category.GetCategoryAttributes)
+		categories.PUT("/:id/attributes", h.require(entities.PermissionCategoriesUpdate), h.category.SetCategoryAttributes)
+	}
+
+	// Importação, exportação e feeds não dependem da representação dos produtos em JSON
+	// e são os mesmos na v2
+	api.POST("/imports/products", deprecated, h.require(entities.PermissionProductsImport), h.imports.ImportProducts)
+	api.GET("/exports/products", deprecated, h.exports.ExportProducts)
+	api.GET("/feeds/merchant", deprecated, h.feed.GetMerchantFeed)
+
+	setupAccountRoutes(api, h)
+}
+
+// setupV2Routes registra as rotas da v2. Estoque, variantes e operações em lote ainda
+// existem apenas na v1.
+func (a *App) setupV2Routes(api *gin.RouterGroup, h routeHandlers) {
+	// Rotas de produtos
+	products := api.Group("/products")
+	{
+		products.GET("", h.productV2.GetProducts)
+		products.GET("/by-sku/:sku", h.productV2.GetProductBySKU)
+		products.GET("/by-slug/:slug", h.productV2.GetProductBySlug)
+		products.GET("/:id", h.productV2.GetProduct)
+		products.POST("", h.require(entities.PermissionProductsCreate), h.productV2.CreateProduct)
+		products.PUT("/:id", h.require(entities.PermissionProductsUpdate), h.productV2.UpdateProduct)
+		products.PATCH("/:id", h.require(entities.PermissionProductsUpdate), h.productV2.PatchProduct)
+		products.DELETE("/:id", h.require(entities.PermissionProductsDelete), h.productV2.DeleteProduct)
+	}
+
+	// Rotas de categorias
+	categories := api.Group("/categories")
+	{
+		categories.GET("", h.categoryV2.GetCategories)
+		categories.GET("/tree", h.categoryV2.GetCategoryTree)
+		categories.GET("/:id", h.categoryV2.GetCategory)
+		categories.POST("", h.require(entities.PermissionCategoriesCreate), h.categoryV2.CreateCategory)
+		categories.PUT("/:id", h.require(entities.PermissionCategoriesUpdate), h.categoryV2.UpdateCategory)
+		categories.PATCH("/:id", h.require(entities.PermissionCategoriesUpdate), h.categoryV2.PatchCategory)
+		categories.DELETE("/:id", h.require(entities.PermissionCategoriesDelete), h.categoryV2.DeleteCategory)
+		categories.GET("/:id/attributes", h.categoryV2.GetCategoryAttributes)
+		categories.PUT("/:id/attributes", h.require(entities.PermissionCategoriesUpdate), h.categoryV2.SetCategoryAttributes)
+	}
+
+	api.POST("/imports/products", h.require(entities.PermissionProductsImport), h.imports.ImportProducts)
+	api.GET("/exports/products", h.exports.ExportProducts)
+	api.GET("/feeds/merchant", h.feed.GetMerchantFeed)
+
+	setupAccountRoutes(api, h)
+}
+
+// setupAccountRoutes registra as rotas de contas, sessões e chaves de API, iguais nas duas versões
+func setupAccountRoutes(api *gin.RouterGroup, h routeHandlers) {
+	api.POST("/auth/register", h.auth.Register)
+	api.POST("/auth/login", h.auth.Login)
+	api.PUT("/auth/password", h.authenticated, h.auth.ChangePassword)
+
+	// Sessão: o refresh token enviado no corpo é a própria credencial
+	api.POST("/auth/refresh", h.auth.Refresh)
+	api.POST("/auth/revoke", h.auth.Revoke)
+
+	// Administração de usuários
+	users := api.Group("/users", h.require(entities.PermissionUsersManage))
+	{
+		users.GET("", h.user.GetUsers)
+		users.GET("/:id", h.user.GetUser)
+		users.POST("", h.user.CreateUser)
+		users.PATCH("/:id", h.user.UpdateUser)
+		users.PUT("/:id/password", h.user.SetPassword)
+		users.POST("/:id/disable", h.user.DisableUser)
+		users.POST("/:id/enable", h.user.EnableUser)
+	}
+
+	// Chaves de API das integrações
+	apiKeys := api.Group("/api-keys", h.require(entities.PermissionAPIKeysManage))
+	{
+		apiKeys.GET("", h.apiKey.GetAPIKeys)
+		apiKeys.GET("/:id", h.apiKey.GetAPIKey)
+		apiKeys.POST("", h.apiKey.CreateAPIKey)
+		apiKeys.POST("/:id/rotate", h.apiKey.RotateAPIKey)
+		apiKeys.POST("/:id/revoke", h.apiKey.RevokeAPIKey)
+	}
+}
+
+// Run inicia os servidores HTTP e gRPC
 func (a *App) Run() error {
+	listener, err := net.Listen("tcp", ":"+a.config.Server.GRPCPort)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if err := a.grpcServer.Serve(listener); err != nil {
+			log.Printf("Servidor gRPC encerrado: %v", err)
+		}
+	}()
+
 	log.Printf("Servidor iniciado na porta %s", a.config.Server.Port)
-	log.Printf("API disponível em: http://localhost:%s/api", a.config.Server.Port)
+	log.Printf("API disponível em: http://localhost:%s/api/v1 e http://localhost:%s/api/v2", a.config.Server.Port, a.config.Server.Port)
+	log.Printf("GraphQL: http://localhost:%s/graphql", a.config.Server.Port)
+	log.Printf("gRPC: localhost:%s", a.config.Server.GRPCPort)
 	log.Printf("Swagger UI: http://localhost:%s/swagger/index.html", a.config.Server.Port)
 	log.Printf("Health check: http://localhost:%s/health", a.config.Server.Port)
 
@@ -128,6 +349,9 @@ func (a *App) Run() error {
 
 // Close fecha a aplicação
 func (a *App) Close() {
+	if a.grpcServer != nil {
+		a.grpcServer.GracefulStop()
+	}
 	if a.db != nil {
 		a.db.Close()
 	}
@@ -3,18 +3,27 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // Config representa as configurações da aplicação
 type Config struct {
 	Server   ServerConfig
 	Database DatabaseConfig
+	Feed     FeedConfig
+	API      APIConfig
+	Auth     AuthConfig
+	CORS     CORSConfig
+	Security SecurityConfig
 }
 
 // ServerConfig representa as configurações do servidor
 type ServerConfig struct {
 	Port string
 	Mode string
+	// GRPCPort é a porta do servidor gRPC, para consumidores internos
+	GRPCPort string
 }
 
 // DatabaseConfig representa as configurações do banco de dados
@@ -27,12 +36,76 @@ type DatabaseConfig struct {
 	SSLMode  string
 }
 
+// FeedConfig representa as configurações dos feeds de produtos (Google Merchant)
+type FeedConfig struct {
+	// BaseURL é o endereço da loja usado nos links dos produtos
+	BaseURL string
+	// ProductPath é o caminho da página do produto; aceita {slug}, {id} e {sku}
+	ProductPath string
+	Title       string
+	Description string
+}
+
+// APIConfig representa as configurações das versões da API
+type APIConfig struct {
+	// V1DeprecatedAt é a data de descontinuação da v1, informada no cabeçalho Deprecation
+	V1DeprecatedAt time.Time
+	// V1Sunset é a data a partir da qual a v1 pode ser removida, informada no cabeçalho Sunset
+	V1Sunset time.Time
+}
+
+// AuthConfig representa as configurações de autenticação (JWT)
+type AuthConfig struct {
+	// Algorithm é o algoritmo de assinatura dos tokens de acesso: HS256 ou RS256
+	Algorithm string
+	// Secret é a chave compartilhada do HS256
+	Secret string
+	// PrivateKeyFile é a chave privada RSA (PEM) usada para assinar tokens com RS256
+	PrivateKeyFile string
+	// PublicKeyFile é a chave pública RSA (PEM) usada para validar tokens com RS256
+	PublicKeyFile string
+	// JWKSFile é um JWK Set com chaves públicas RSA adicionais, escolhidas pelo kid do token
+	JWKSFile string
+	// KeyID é o kid informado nos tokens assinados com RS256
+	KeyID    string
+	Issuer   string
+	Audience string
+	// AccessTokenTTL é a validade dos tokens de acesso
+	AccessTokenTTL time.Duration
+	// RefreshTokenTTL é a validade de cada refresh token; a sessão se estende a cada renovação
+	RefreshTokenTTL time.Duration
+	// PasswordHashCost é o custo do bcrypt no hash das senhas
+	PasswordHashCost int
+}
+
+// CORSConfig representa as configurações de CORS
+type CORSConfig struct {
+	// AllowedOrigins são as origens aceitas (ex: https://www.minhaloja.com.br). Aceita curinga de
+	// subdomínio (https://*.minhaloja.com.br) e "*" para qualquer origem
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	// AllowCredentials permite que o navegador envie cookies e credenciais; não combina com "*"
+	AllowCredentials bool
+	// MaxAge é por quanto tempo o navegador guarda a resposta da verificação prévia (preflight)
+	MaxAge time.Duration
+}
+
+// SecurityConfig representa as configurações dos cabeçalhos de segurança
+type SecurityConfig struct {
+	// Environment escolhe os cabeçalhos predefinidos: development, staging ou production
+	Environment string
+	// HSTSMaxAge substitui a validade do Strict-Transport-Security do ambiente
+	HSTSMaxAge time.Duration
+}
+
 // Load carrega as configurações da aplicação
 func Load() *Config {
 	return &Config{
 		Server: ServerConfig{
-			Port: getEnv("PORT", "8080"),
-			Mode: getEnv("GIN_MODE", "release"),
+			Port:     getEnv("PORT", "8080"),
+			Mode:     getEnv("GIN_MODE", "release"),
+			GRPCPort: getEnv("GRPC_PORT", "9090"),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -42,6 +115,40 @@ func Load() *Config {
 			Name:     getEnv("DB_NAME", "catalogo_produtos"),
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
 		},
+		Feed: FeedConfig{
+			BaseURL:     getEnv("FEED_BASE_URL", "http://localhost:3000"),
+			ProductPath: getEnv("FEED_PRODUCT_PATH", "/produtos/{slug}"),
+			Title:       getEnv("FEED_TITLE", "Catálogo de Produtos"),
+			Description: getEnv("FEED_DESCRIPTION", "Feed de produtos do catálogo"),
+		},
+		API: APIConfig{
+			V1DeprecatedAt: getEnvAsDate("API_V1_DEPRECATED_AT", "2026-10-01"),
+			V1Sunset:       getEnvAsDate("API_V1_SUNSET", "2027-04-01"),
+		},
+		Auth: AuthConfig{
+			Algorithm:        getEnv("AUTH_JWT_ALGORITHM", "HS256"),
+			Secret:           getEnv("AUTH_JWT_SECRET", ""),
+			PrivateKeyFile:   getEnv("AUTH_JWT_PRIVATE_KEY_FILE", ""),
+			PublicKeyFile:    getEnv("AUTH_JWT_PUBLIC_KEY_FILE", ""),
+			JWKSFile:         getEnv("AUTH_JWKS_FILE", ""),
+			KeyID:            getEnv("AUTH_JWT_KEY_ID", ""),
+			Issuer:           getEnv("AUTH_JWT_ISSUER", "catalogo-produtos"),
+			Audience:         getEnv("AUTH_JWT_AUDIENCE", "catalogo-produtos-api"),
+			AccessTokenTTL:   getEnvAsDuration("AUTH_ACCESS_TOKEN_TTL", 15*time.Minute),
+			RefreshTokenTTL:  getEnvAsDuration("AUTH_REFRESH_TOKEN_TTL", 30*24*time.Hour),
+			PasswordHashCost: getEnvAsInt("AUTH_BCRYPT_COST", 12),
+		},
+		CORS: CORSConfig{
+			AllowedOrigins:   getEnvAsList("CORS_ALLOWED_ORIGINS", "http://localhost:3000,http://localhost:5173"),
+			AllowedMethods:   getEnvAsList("CORS_ALLOWED_METHODS", "GET,POST,PUT,PATCH,DELETE,OPTIONS"),
+			AllowedHeaders:   getEnvAsList("CORS_ALLOWED_HEADERS", "Origin,Content-Type,Accept,Authorization,X-API-Key,If-Match,If-None-Match"),
+			AllowCredentials: getEnvAsBool("CORS_ALLOW_CREDENTIALS", false),
+			MaxAge:           getEnvAsDuration("CORS_MAX_AGE", 12*time.Hour),
+		},
+		Security: SecurityConfig{
+			Environment: getEnv("APP_ENV", "production"),
+			HSTSMaxAge:  getEnvAsDuration("SECURITY_HSTS_MAX_AGE", 0),
+		},
 	}
 }
 
@@ -62,3 +169,39 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// getEnvAsBool obtém uma variável de ambiente como booleano (ex: true, false, 1, 0) ou retorna um valor padrão
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+// getEnvAsList obtém uma variável de ambiente como lista separada por vírgulas ou retorna a lista padrão
+func getEnvAsList(key, defaultValue string) []string {
+	var list []string
+	for _, item := range strings.Split(getEnv(key, defaultValue), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// getEnvAsDate obtém uma variável de ambiente como data (YYYY-MM-DD) ou retorna a data padrão
+func getEnvAsDate(key, defaultValue string) time.Time {
+	if value, err := time.Parse("2006-01-02", os.Getenv(key)); err == nil {
+		return value
+	}
+	value, _ := time.Parse("2006-01-02", defaultValue)
+	return value
+}
+
+// getEnvAsDuration obtém uma variável de ambiente como duração (ex: 15m, 720h) ou retorna um valor padrão
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value, err := time.ParseDuration(os.Getenv(key)); err == nil && value > 0 {
+		return value
+	}
+	return defaultValue
+}
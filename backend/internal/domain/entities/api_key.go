@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"strconv"
+	"time"
+)
+
+// APIKey representa uma chave de acesso de um sistema integrado (ex: ERP, marketplace).
+// Apenas o hash da chave é armazenado; Prefix identifica a chave nas listagens.
+type APIKey struct {
+	ID      uint
+	Name    string
+	Prefix  string
+	KeyHash string
+	Scopes  []Scope
+	// ExpiresAt é o fim da validade da chave; nil para chaves sem expiração
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	// CreatedBy é o subject de quem criou a chave
+	CreatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsActive indica se a chave pode ser usada no instante informado
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// APIKeySubject retorna o subject das requisições autenticadas com a chave (ex: "api_key:7")
+func APIKeySubject(id uint) string {
+	return "api_key:" + strconv.FormatUint(uint64(id), 10)
+}
+
+// Scope é um conjunto de permissões concedido a uma chave de API
+type Scope string
+
+// Escopos das chaves de API
+const (
+	ScopeProductsRead    Scope = "products:read"
+	ScopeProductsWrite   Scope = "products:write"
+	ScopeCategoriesRead  Scope = "categories:read"
+	ScopeCategoriesWrite Scope = "categories:write"
+	ScopeStockWrite      Scope = "stock:write"
+	ScopeImportsRun      Scope = "imports:run"
+)
+
+// scopePermissions lista as permissões concedidas por cada escopo. Leituras são públicas, de
+// modo que os escopos de leitura são aceitos mas não concedem nada; nenhum escopo concede a
+// administração de usuários ou de chaves.
+var scopePermissions = map[Scope][]Permission{
+	ScopeProductsRead:   nil,
+	ScopeCategoriesRead: nil,
+	ScopeProductsWrite: {
+		PermissionProductsCreate,
+		PermissionProductsUpdate,
+		PermissionProductsDelete,
+		PermissionProductsBulk,
+	},
+	ScopeCategoriesWrite: {
+		PermissionCategoriesCreate,
+		PermissionCategoriesUpdate,
+		PermissionCategoriesDelete,
+	},
+	ScopeStockWrite: {PermissionStockWrite},
+	ScopeImportsRun: {PermissionProductsImport},
+}
+
+// IsValid indica se o escopo existe
+func (s Scope) IsValid() bool {
+	_, ok := scopePermissions[s]
+	return ok
+}
+
+// ScopesAllow indica se algum dos escopos concede a permissão
+func ScopesAllow(scopes []Scope, permission Permission) bool {
+	for _, scope := range scopes {
+		for _, granted := range scopePermissions[scope] {
+			if granted == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAPIKeyIsActive(t *testing.T) {
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		key  APIKey
+		want bool
+	}{
+		{name: "sem expiração", key: APIKey{}, want: true},
+		{name: "expira no futuro", key: APIKey{ExpiresAt: &future}, want: true},
+		{name: "expirada", key: APIKey{ExpiresAt: &past}, want: false},
+		{name: "expira no instante", key: APIKey{ExpiresAt: &now}, want: false},
+		{name: "revogada", key: APIKey{RevokedAt: &past}, want: false},
+		{name: "revogada antes de expirar", key: APIKey{ExpiresAt: &future, RevokedAt: &past}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.key.IsActive(now); got != tt.want {
+				t.Errorf("IsActive() = %v, esperado %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScopesAllow(t *testing.T) {
+	tests := []struct {
+		name       string
+		scopes     []Scope
+		permission Permission
+		want       bool
+	}{
+		{name: "sem escopos", scopes: nil, permission: PermissionProductsCreate, want: false},
+		{name: "produtos cria", scopes: []Scope{ScopeProductsWrite}, permission: PermissionProductsCreate, want: true},
+		{name: "produtos remove", scopes: []Scope{ScopeProductsWrite}, permission: PermissionProductsDelete, want: true},
+		{name: "produtos em lote", scopes: []Scope{ScopeProductsWrite}, permission: PermissionProductsBulk, want: true},
+		{name: "produtos não importa", scopes: []Scope{ScopeProductsWrite}, permission: PermissionProductsImport, want: false},
+		{name: "produtos não movimenta estoque", scopes: []Scope{ScopeProductsWrite}, permission: PermissionStockWrite, want: false},
+		{name: "categorias", scopes: []Scope{ScopeCategoriesWrite}, permission: PermissionCategoriesDelete, want: true},
+		{name: "estoque", scopes: []Scope{ScopeStockWrite}, permission: PermissionStockWrite, want: true},
+		{name: "importações", scopes: []Scope{ScopeImportsRun}, permission: PermissionProductsImport, want: true},
+		{name: "basta um dos escopos", scopes: []Scope{ScopeStockWrite, ScopeCategoriesWrite}, permission: PermissionCategoriesCreate, want: true},
+		{name: "leitura não concede escrita", scopes: []Scope{ScopeProductsRead, ScopeCategoriesRead}, permission: PermissionProductsCreate, want: false},
+		{name: "escopo desconhecido", scopes: []Scope{"products:admin"}, permission: PermissionProductsCreate, want: false},
+		{
+			name:       "nenhum escopo gerencia usuários",
+			scopes:     []Scope{ScopeProductsWrite, ScopeCategoriesWrite, ScopeStockWrite, ScopeImportsRun},
+			permission: PermissionUsersManage,
+			want:       false,
+		},
+		{
+			name:       "nenhum escopo gerencia chaves",
+			scopes:     []Scope{ScopeProductsWrite, ScopeCategoriesWrite, ScopeStockWrite, ScopeImportsRun},
+			permission: PermissionAPIKeysManage,
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScopesAllow(tt.scopes, tt.permission); got != tt.want {
+				t.Errorf("ScopesAllow(%v, %s) = %v, esperado %v", tt.scopes, tt.permission, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScopeIsValid(t *testing.T) {
+	tests := []struct {
+		scope Scope
+		want  bool
+	}{
+		{scope: ScopeProductsWrite, want: true},
+		{scope: ScopeImportsRun, want: true},
+		// Os escopos de leitura continuam aceitos, embora as leituras sejam públicas
+		{scope: ScopeProductsRead, want: true},
+		{scope: ScopeCategoriesRead, want: true},
+		{scope: "users:manage", want: false},
+		{scope: "", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.scope.IsValid(); got != tt.want {
+			t.Errorf("Scope(%q).IsValid() = %v, esperado %v", tt.scope, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package entities
+
+// AttributeType representa o tipo de um atributo de categoria
+type AttributeType string
+
+// Tipos de atributo suportados
+const (
+	AttributeString  AttributeType = "string"
+	AttributeNumber  AttributeType = "number"
+	AttributeEnum    AttributeType = "enum"
+	AttributeBoolean AttributeType = "boolean"
+)
+
+// CategoryAttribute representa a definição de um atributo tipado de uma categoria
+// (ex: ram_gb, número, em GB, obrigatório)
+type CategoryAttribute struct {
+	ID         uint          `json:"id"`
+	CategoryID uint          `json:"category_id"`
+	Key        string        `json:"key"`
+	Label      string        `json:"label"`
+	Type       AttributeType `json:"type"`
+	Unit       string        `json:"unit"`
+	Required   bool          `json:"required"`
+	// Options lista os valores aceitos por atributos do tipo enum
+	Options  []string `json:"options"`
+	Position int      `json:"position"`
+}
@@ -0,0 +1,43 @@
+package entities
+
+import "time"
+
+// TokenClaims representa as informações de um token de acesso válido
+type TokenClaims struct {
+	// Subject identifica quem se autenticou (claim sub)
+	Subject string `json:"sub"`
+	// Roles são os papéis concedidos ao subject (claim roles); papéis desconhecidos são ignorados
+	Roles []Role `json:"roles"`
+	// Scopes são os escopos de uma chave de API; tokens de acesso não têm escopos
+	Scopes []Scope `json:"scopes,omitempty"`
+	// TokenID identifica o token (claim jti)
+	TokenID   string    `json:"jti"`
+	IssuedAt  time.Time `json:"iat"`
+	ExpiresAt time.Time `json:"exp"`
+	// Extra contém as demais claims do token
+	Extra map[string]interface{} `json:"extra,omitempty"`
+}
+
+// RefreshToken representa um refresh token emitido. Apenas o hash do token é armazenado.
+// Tokens emitidos a partir de um mesmo login formam uma família: a cada renovação o token
+// usado é revogado e substituído por outro da mesma família.
+type RefreshToken struct {
+	ID        uint
+	FamilyID  string
+	Subject   string
+	TokenHash string
+	// Claims são copiadas para os tokens de acesso emitidos na renovação
+	Claims       map[string]interface{}
+	ExpiresAt    time.Time
+	RevokedAt    *time.Time
+	ReplacedByID *uint
+	CreatedAt    time.Time
+}
+
+// TokenPair representa os tokens emitidos em um login ou renovação
+type TokenPair struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
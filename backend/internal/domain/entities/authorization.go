@@ -0,0 +1,94 @@
+package entities
+
+// Role é um papel atribuído a quem acessa o catálogo; cada papel concede um conjunto de permissões
+type Role string
+
+// Papéis do catálogo, do menos ao mais privilegiado
+const (
+	// RoleViewer não concede nenhuma escrita: as leituras do catálogo são públicas
+	RoleViewer Role = "viewer"
+	// RoleEditor também cria e altera produtos, categorias e estoque
+	RoleEditor Role = "editor"
+	// RoleAdmin também remove produtos e categorias, executa operações em lote e importações
+	// e gerencia os usuários e as chaves de API
+	RoleAdmin Role = "admin"
+)
+
+// RolesClaim é a claim do token com a lista de papéis do subject
+const RolesClaim = "roles"
+
+// Permission é uma operação do catálogo que exige autorização (ex: "products:delete")
+type Permission string
+
+// Permissões do catálogo
+const (
+	PermissionProductsCreate   Permission = "products:create"
+	PermissionProductsUpdate   Permission = "products:update"
+	PermissionProductsDelete   Permission = "products:delete"
+	PermissionProductsBulk     Permission = "products:bulk"
+	PermissionProductsImport   Permission = "products:import"
+	PermissionStockWrite       Permission = "stock:write"
+	PermissionCategoriesCreate Permission = "categories:create"
+	PermissionCategoriesUpdate Permission = "categories:update"
+	PermissionCategoriesDelete Permission = "categories:delete"
+	PermissionUsersManage      Permission = "users:manage"
+	PermissionAPIKeysManage    Permission = "api_keys:manage"
+)
+
+// rolePermissions lista as permissões concedidas por cada papel. Cada papel inclui as do anterior.
+var rolePermissions = map[Role][]Permission{
+	RoleViewer: {},
+	RoleEditor: {
+		PermissionProductsCreate,
+		PermissionProductsUpdate,
+		PermissionStockWrite,
+		PermissionCategoriesCreate,
+		PermissionCategoriesUpdate,
+	},
+	RoleAdmin: {
+		PermissionProductsCreate,
+		PermissionProductsUpdate,
+		PermissionProductsDelete,
+		PermissionProductsBulk,
+		PermissionProductsImport,
+		PermissionStockWrite,
+		PermissionCategoriesCreate,
+		PermissionCategoriesUpdate,
+		PermissionCategoriesDelete,
+		PermissionUsersManage,
+		PermissionAPIKeysManage,
+	},
+}
+
+// IsValid indica se o papel existe
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// Permissions retorna as permissões concedidas pelo papel
+func (r Role) Permissions() []Permission {
+	return rolePermissions[r]
+}
+
+// HasRole indica se o papel está entre os papéis
+func HasRole(roles []Role, role Role) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission indica se algum dos papéis concede a permissão
+func HasPermission(roles []Role, permission Permission) bool {
+	for _, role := range roles {
+		for _, granted := range rolePermissions[role] {
+			if granted == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package entities
+
+import "testing"
+
+func TestHasPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		roles      []Role
+		permission Permission
+		want       bool
+	}{
+		{name: "sem papéis", roles: nil, permission: PermissionProductsCreate, want: false},
+		{name: "viewer não escreve", roles: []Role{RoleViewer}, permission: PermissionProductsCreate, want: false},
+		{name: "editor cria produtos", roles: []Role{RoleEditor}, permission: PermissionProductsCreate, want: true},
+		{name: "editor movimenta estoque", roles: []Role{RoleEditor}, permission: PermissionStockWrite, want: true},
+		{name: "editor não remove produtos", roles: []Role{RoleEditor}, permission: PermissionProductsDelete, want: false},
+		{name: "editor não remove categorias", roles: []Role{RoleEditor}, permission: PermissionCategoriesDelete, want: false},
+		{name: "editor não importa", roles: []Role{RoleEditor}, permission: PermissionProductsImport, want: false},
+		{name: "editor não gerencia usuários", roles: []Role{RoleEditor}, permission: PermissionUsersManage, want: false},
+		{name: "admin remove produtos", roles: []Role{RoleAdmin}, permission: PermissionProductsDelete, want: true},
+		{name: "admin gerencia usuários", roles: []Role{RoleAdmin}, permission: PermissionUsersManage, want: true},
+		{name: "admin gerencia chaves", roles: []Role{RoleAdmin}, permission: PermissionAPIKeysManage, want: true},
+		{name: "basta um dos papéis", roles: []Role{RoleViewer, RoleEditor}, permission: PermissionCategoriesUpdate, want: true},
+		{name: "papel desconhecido", roles: []Role{"superuser"}, permission: PermissionProductsCreate, want: false},
+		{name: "permissão desconhecida", roles: []Role{RoleAdmin}, permission: "products:read", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasPermission(tt.roles, tt.permission); got != tt.want {
+				t.Errorf("HasPermission(%v, %s) = %v, esperado %v", tt.roles, tt.permission, got, tt.want)
+			}
+		})
+	}
+}
+
+// Cada papel inclui as permissões do papel anterior
+func TestRolesAreCumulative(t *testing.T) {
+	order := []Role{RoleViewer, RoleEditor, RoleAdmin}
+	for i := 1; i < len(order); i++ {
+		for _, permission := range order[i-1].Permissions() {
+			if !HasPermission([]Role{order[i]}, permission) {
+				t.Errorf("%s não tem %s, concedida a %s", order[i], permission, order[i-1])
+			}
+		}
+	}
+}
+
+func TestRoleIsValid(t *testing.T) {
+	tests := []struct {
+		role Role
+		want bool
+	}{
+		{role: RoleViewer, want: true},
+		{role: RoleEditor, want: true},
+		{role: RoleAdmin, want: true},
+		{role: "Admin", want: false},
+		{role: "", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.IsValid(); got != tt.want {
+			t.Errorf("Role(%q).IsValid() = %v, esperado %v", tt.role, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// DefaultCurrency é a moeda usada quando nenhuma é informada
+const DefaultCurrency = "BRL"
+
+// maxMoneyUnits limita a parte inteira dos valores: as colunas de preço decimal(10,2) guardam até
+// 99999999.99 em qualquer moeda
+const maxMoneyUnits = 100000000
+
+var (
+	errMoneyLimit    = fmt.Errorf("valor monetário deve ser menor que %d", maxMoneyUnits)
+	errMoneyOverflow = errors.New("valor monetário muito grande")
+)
+
+// currencyExponents define quantas casas decimais cada moeda suportada possui
+var currencyExponents = map[string]int{
+	"BRL": 2,
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"ARS": 2,
+	"CLP": 0,
+	"JPY": 0,
+}
+
+// SupportedCurrencies lista as moedas suportadas, em ordem alfabética
+func SupportedCurrencies() []string {
+	currencies := make([]string, 0, len(currencyExponents))
+	for currency := range currencyExponents {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+// IsSupportedCurrency indica se a moeda é suportada, sem diferenciar maiúsculas
+func IsSupportedCurrency(currency string) bool {
+	_, ok := currencyExponents[strings.ToUpper(currency)]
+	return ok
+}
+
+// Money representa um valor monetário exato em unidades mínimas (ex: centavos)
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// NewMoney cria um valor monetário a partir de unidades mínimas
+func NewMoney(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// ParseMoney converte um valor decimal (ex: "2999.99") para Money sem perda de precisão.
+// Valores com mais casas decimais do que a moeda permite ou fora do limite são rejeitados.
+func ParseMoney(value, currency string) (Money, error) {
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	currency = strings.ToUpper(currency)
+
+	exponent, ok := currencyExponents[currency]
+	if !ok {
+		return Money{}, fmt.Errorf("moeda não suportada: %s", currency)
+	}
+
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	whole, fraction, hasFraction := strings.Cut(value, ".")
+	if whole == "" || (hasFraction && fraction == "") || !isDigits(whole) || !isDigits(fraction) {
+		return Money{}, fmt.Errorf("valor monetário inválido: %q", value)
+	}
+	if len(fraction) > exponent {
+		return Money{}, fmt.Errorf("valor monetário deve ter no máximo %d casas decimais", exponent)
+	}
+
+	// Completar as casas decimais e acumular em unidades mínimas
+	digits := whole + fraction + strings.Repeat("0", exponent-len(fraction))
+	var amount int64
+	for _, d := range digits {
+		if amount > (math.MaxInt64-int64(d-'0'))/10 {
+			return Money{}, errMoneyLimit
+		}
+		amount = amount*10 + int64(d-'0')
+	}
+
+	if negative {
+		amount = -amount
+	}
+
+	money := Money{Amount: amount, Currency: currency}
+	if !money.IsWithinLimit() {
+		return Money{}, errMoneyLimit
+	}
+	return money, nil
+}
+
+// ParseDecimalMoney converte um valor decimal de escala fixa (ex: "1000.00", lido de uma coluna
+// decimal(10,2)) para Money, descartando as casas zeradas além das que a moeda possui.
+// Casas excedentes diferentes de zero continuam rejeitadas.
+func ParseDecimalMoney(value, currency string) (Money, error) {
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	if whole, fraction, ok := strings.Cut(strings.TrimSpace(value), "."); ok {
+		exponent := currencyExponents[strings.ToUpper(currency)]
+		if len(fraction) > exponent && strings.Trim(fraction[exponent:], "0") == "" {
+			value = whole
+			if exponent > 0 {
+				value += "." + fraction[:exponent]
+			}
+		}
+	}
+	return ParseMoney(value, currency)
+}
+
+// String retorna o valor decimal sem a moeda (ex: "2999.99")
+func (m Money) String() string {
+	exponent := currencyExponents[m.Currency]
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	if exponent == 0 {
+		return fmt.Sprintf("%s%d", sign, amount)
+	}
+
+	scale := int64(math.Pow10(exponent))
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/scale, exponent, amount%scale)
+}
+
+// IsWithinLimit indica se o valor cabe nas colunas de preço, ou seja, se a parte inteira tem menos de
+// 100000000 unidades
+func (m Money) IsWithinLimit() bool {
+	units := m.Amount / int64(math.Pow10(currencyExponents[m.Currency]))
+	return units < maxMoneyUnits && units > -maxMoneyUnits
+}
+
+// IsPositive indica se o valor é maior que zero
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+// Add soma dois valores da mesma moeda
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("não é possível somar %s com %s", m.Currency, other.Currency)
+	}
+	sum := m.Amount + other.Amount
+	if (other.Amount > 0 && sum < m.Amount) || (other.Amount < 0 && sum > m.Amount) {
+		return Money{}, errMoneyOverflow
+	}
+	return Money{Amount: sum, Currency: m.Currency}, nil
+}
+
+// Multiply multiplica o valor por uma quantidade inteira
+func (m Money) Multiply(quantity int64) (Money, error) {
+	product := m.Amount * quantity
+	if quantity != 0 && (product/quantity != m.Amount || (quantity == -1 && m.Amount == math.MinInt64)) {
+		return Money{}, errMoneyOverflow
+	}
+	return Money{Amount: product, Currency: m.Currency}, nil
+}
+
+// Percentage calcula uma porcentagem do valor em pontos-base (1250 = 12,5%),
+// arredondando para a unidade mínima mais próxima
+func (m Money) Percentage(basisPoints int64) Money {
+	product := m.Amount * basisPoints
+	half := int64(5000)
+	if product < 0 {
+		half = -half
+	}
+	return Money{Amount: (product + half) / 10000, Currency: m.Currency}
+}
+
+// isDigits indica se a string contém apenas dígitos
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
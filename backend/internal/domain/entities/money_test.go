@@ -0,0 +1,175 @@
+package entities
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		currency string
+		want     Money
+		wantErr  bool
+	}{
+		{name: "inteiro", value: "10", currency: "BRL", want: Money{Amount: 1000, Currency: "BRL"}},
+		{name: "centavos", value: "2999.99", currency: "BRL", want: Money{Amount: 299999, Currency: "BRL"}},
+		{name: "uma casa decimal", value: "0.5", currency: "USD", want: Money{Amount: 50, Currency: "USD"}},
+		{name: "moeda padrão", value: "1.00", currency: "", want: Money{Amount: 100, Currency: "BRL"}},
+		{name: "moeda em minúsculas", value: "1", currency: "eur", want: Money{Amount: 100, Currency: "EUR"}},
+		{name: "moeda sem casas decimais", value: "1500", currency: "JPY", want: Money{Amount: 1500, Currency: "JPY"}},
+		{name: "negativo", value: "-3.10", currency: "BRL", want: Money{Amount: -310, Currency: "BRL"}},
+		{name: "espaços", value: " 7.25 ", currency: "BRL", want: Money{Amount: 725, Currency: "BRL"}},
+		{name: "casas demais", value: "1.999", currency: "BRL", wantErr: true},
+		{name: "casas em moeda sem centavos", value: "1.5", currency: "JPY", wantErr: true},
+		{name: "moeda não suportada", value: "1", currency: "XYZ", wantErr: true},
+		{name: "vazio", value: "", currency: "BRL", wantErr: true},
+		{name: "ponto sem casas", value: "1.", currency: "BRL", wantErr: true},
+		{name: "sem parte inteira", value: ".5", currency: "BRL", wantErr: true},
+		{name: "vírgula decimal", value: "1,50", currency: "BRL", wantErr: true},
+		{name: "notação científica", value: "1e3", currency: "BRL", wantErr: true},
+		{name: "maior valor da coluna", value: "99999999.99", currency: "BRL", want: Money{Amount: 9999999999, Currency: "BRL"}},
+		{name: "acima da coluna", value: "100000000.00", currency: "BRL", wantErr: true},
+		{name: "acima da coluna sem centavos", value: "100000000", currency: "JPY", wantErr: true},
+		{name: "negativo acima da coluna", value: "-100000000", currency: "BRL", wantErr: true},
+		{name: "estouro", value: "92233720368547758.08", currency: "BRL", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.value, tt.currency)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseMoney(%q, %q) = %v, esperado erro", tt.value, tt.currency, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMoney(%q, %q) retornou erro: %v", tt.value, tt.currency, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMoney(%q, %q) = %+v, esperado %+v", tt.value, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDecimalMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		currency string
+		want     Money
+		wantErr  bool
+	}{
+		{name: "escala da moeda", value: "1000.00", currency: "BRL", want: Money{Amount: 100000, Currency: "BRL"}},
+		{name: "zeros excedentes", value: "19.9000", currency: "BRL", want: Money{Amount: 1990, Currency: "BRL"}},
+		{name: "zeros em moeda sem centavos", value: "1500.00", currency: "JPY", want: Money{Amount: 1500, Currency: "JPY"}},
+		{name: "sem casas decimais", value: "42", currency: "USD", want: Money{Amount: 4200, Currency: "USD"}},
+		{name: "moeda padrão", value: "5.50", currency: "", want: Money{Amount: 550, Currency: "BRL"}},
+		{name: "casa excedente diferente de zero", value: "1.005", currency: "BRL", wantErr: true},
+		{name: "centavos em moeda sem centavos", value: "1500.50", currency: "JPY", wantErr: true},
+		{name: "inválido", value: "abc", currency: "BRL", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDecimalMoney(tt.value, tt.currency)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDecimalMoney(%q, %q) = %v, esperado erro", tt.value, tt.currency, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDecimalMoney(%q, %q) retornou erro: %v", tt.value, tt.currency, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDecimalMoney(%q, %q) = %+v, esperado %+v", tt.value, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		money Money
+		want  string
+	}{
+		{money: Money{Amount: 299999, Currency: "BRL"}, want: "2999.99"},
+		{money: Money{Amount: 5, Currency: "BRL"}, want: "0.05"},
+		{money: Money{Amount: 0, Currency: "USD"}, want: "0.00"},
+		{money: Money{Amount: -310, Currency: "BRL"}, want: "-3.10"},
+		{money: Money{Amount: -5, Currency: "EUR"}, want: "-0.05"},
+		{money: Money{Amount: 1500, Currency: "JPY"}, want: "1500"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.money.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, esperado %q", tt.money, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyRoundTrip(t *testing.T) {
+	for _, value := range []string{"0.01", "10.00", "2999.99", "-0.50"} {
+		money, err := ParseMoney(value, "BRL")
+		if err != nil {
+			t.Fatalf("ParseMoney(%q) retornou erro: %v", value, err)
+		}
+		if got := money.String(); got != value {
+			t.Errorf("ParseMoney(%q).String() = %q", value, got)
+		}
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	price := Money{Amount: 1999, Currency: "BRL"}
+
+	sum, err := price.Add(Money{Amount: 1, Currency: "BRL"})
+	if err != nil || sum != (Money{Amount: 2000, Currency: "BRL"}) {
+		t.Errorf("Add = %+v, %v", sum, err)
+	}
+	if _, err := price.Add(Money{Amount: 1, Currency: "USD"}); err == nil {
+		t.Error("Add entre moedas diferentes deveria falhar")
+	}
+	if _, err := (Money{Amount: math.MaxInt64, Currency: "BRL"}).Add(Money{Amount: 1, Currency: "BRL"}); err == nil {
+		t.Error("Add deveria falhar quando a soma estoura int64")
+	}
+	if _, err := (Money{Amount: math.MinInt64, Currency: "BRL"}).Add(Money{Amount: -1, Currency: "BRL"}); err == nil {
+		t.Error("Add deveria falhar quando a soma estoura int64 para baixo")
+	}
+	if got, err := price.Multiply(3); err != nil || got != (Money{Amount: 5997, Currency: "BRL"}) {
+		t.Errorf("Multiply(3) = %+v, %v", got, err)
+	}
+	for _, tt := range []struct {
+		amount   int64
+		quantity int64
+	}{
+		{amount: math.MaxInt64/2 + 1, quantity: 2},
+		{amount: math.MinInt64, quantity: -1},
+		{amount: -1, quantity: math.MinInt64},
+	} {
+		if _, err := (Money{Amount: tt.amount, Currency: "BRL"}).Multiply(tt.quantity); err == nil {
+			t.Errorf("Multiply(%d) de %d deveria falhar por estouro", tt.quantity, tt.amount)
+		}
+	}
+
+	percentages := []struct {
+		amount      int64
+		basisPoints int64
+		want        int64
+	}{
+		{amount: 1000, basisPoints: 1250, want: 125},
+		{amount: 999, basisPoints: 1000, want: 100},
+		{amount: 1004, basisPoints: 500, want: 50},
+		{amount: -999, basisPoints: 1000, want: -100},
+	}
+	for _, tt := range percentages {
+		got := Money{Amount: tt.amount, Currency: "BRL"}.Percentage(tt.basisPoints)
+		if got.Amount != tt.want {
+			t.Errorf("Percentage(%d) de %d = %d, esperado %d", tt.basisPoints, tt.amount, got.Amount, tt.want)
+		}
+	}
+}
@@ -4,21 +4,53 @@ import "time"
 
 // Product representa a entidade de domínio de um produto
 type Product struct {
-	ID          uint      `json:"id"`
-	Name        string    `json:"name"`
-	Image       string    `json:"image"`
-	Price       float64   `json:"price"`
-	CategoryID  uint      `json:"category_id"`
-	Category    Category  `json:"category"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          uint     `json:"id"`
+	SKU         string   `json:"sku"`
+	Slug        string   `json:"slug"`
+	Name        string   `json:"name"`
+	Image       string   `json:"image"`
+	Price       Money    `json:"price"`
+	CategoryID  uint     `json:"category_id"`
+	Category    Category `json:"category"`
+	Description string   `json:"description"`
+	// StockQuantity é mantido pelo livro-razão de movimentações de estoque
+	StockQuantity int64 `json:"stock_quantity"`
+	// Attributes contém as especificações do produto, validadas pelo esquema da categoria
+	Attributes map[string]interface{} `json:"attributes"`
+	Options    []ProductOption        `json:"options"`
+	Variants   []ProductVariant       `json:"variants"`
+	// Version é incrementada pelo banco a cada alteração do produto, de suas opções ou variantes
+	Version   int64     `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Preenchidos apenas em buscas textuais
+	SearchRank float64          `json:"-"`
+	Highlight  *SearchHighlight `json:"highlight,omitempty"`
+}
+
+// SearchHighlight representa os trechos destacados de um resultado de busca
+type SearchHighlight struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
 }
 
 // Category representa a entidade de domínio de uma categoria
 type Category struct {
-	ID        uint      `json:"id"`
-	Name      string    `json:"name"`
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	ParentID *uint  `json:"parent_id"`
+	// Version é incrementada pelo banco a cada alteração da categoria
+	Version   int64     `json:"version"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	// Ancestrais da categoria, da raiz até o pai imediato
+	Ancestors []Category `json:"ancestors,omitempty"`
+}
+
+// CategoryNode representa uma categoria na árvore de categorias
+type CategoryNode struct {
+	Category
+	Children []CategoryNode `json:"children"`
 }
@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Slugify gera um identificador de URL sem acentos (ex: "Tênis Nike" → "tenis-nike")
+func Slugify(value string) string {
+	// Decompor os caracteres e remover as marcas de acentuação
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	normalized, _, err := transform.String(t, value)
+	if err != nil {
+		normalized = value
+	}
+
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(normalized) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			dash = false
+		case b.Len() > 0 && !dash:
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "-")
+}
@@ -0,0 +1,30 @@
+package entities
+
+import "testing"
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "Tênis Nike", want: "tenis-nike"},
+		{value: "Smartphone Galaxy S23", want: "smartphone-galaxy-s23"},
+		{value: "Ação & Reação", want: "acao-reacao"},
+		{value: "  espaços   nas pontas  ", want: "espacos-nas-pontas"},
+		{value: "Café---com__Leite!!", want: "cafe-com-leite"},
+		{value: "ÇÃÕ ÉÍÚ ü", want: "cao-eiu-u"},
+		{value: "Notebook 15,6\" i7", want: "notebook-15-6-i7"},
+		{value: "já-é-slug", want: "ja-e-slug"},
+		{value: "--", want: ""},
+		{value: "", want: ""},
+		{value: "日本語", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := Slugify(tt.value); got != tt.want {
+				t.Errorf("Slugify(%q) = %q, esperado %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package entities
+
+import "time"
+
+// StockMovementType representa o tipo de uma movimentação de estoque
+type StockMovementType string
+
+// Tipos de movimentação de estoque
+const (
+	StockMovementReceipt    StockMovementType = "receipt"
+	StockMovementSale       StockMovementType = "sale"
+	StockMovementAdjustment StockMovementType = "adjustment"
+	StockMovementReturn     StockMovementType = "return"
+)
+
+// StockMovement representa um lançamento no livro-razão de estoque de um produto.
+// Quantity é a variação aplicada ao estoque: positiva para entradas e negativa para saídas.
+type StockMovement struct {
+	ID        uint `json:"id"`
+	ProductID uint `json:"product_id"`
+	// VariantID identifica a variante movimentada; vazio para o estoque do próprio produto
+	VariantID    *uint             `json:"variant_id"`
+	Type         StockMovementType `json:"type"`
+	Quantity     int64             `json:"quantity"`
+	BalanceAfter int64             `json:"balance_after"`
+	Note         string            `json:"note"`
+	CreatedAt    time.Time         `json:"created_at"`
+}
@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// User representa uma conta de acesso ao catálogo. A senha é armazenada apenas como hash.
+type User struct {
+	ID uint
+	// Email identifica o usuário no login; é armazenado em minúsculas
+	Email        string
+	Name         string
+	PasswordHash string
+	Roles        []Role
+	// Disabled impede o login e invalida os tokens já emitidos
+	Disabled    bool
+	LastLoginAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// userSubjectPrefix distingue os subjects de usuários dos emitidos para integrações
+const userSubjectPrefix = "user:"
+
+// UserSubject retorna o subject dos tokens emitidos para o usuário (ex: "user:42")
+func UserSubject(id uint) string {
+	return userSubjectPrefix + strconv.FormatUint(uint64(id), 10)
+}
+
+// ParseUserSubject obtém o ID do usuário de um subject; ok é falso para subjects que não são de usuários
+func ParseUserSubject(subject string) (id uint, ok bool) {
+	if !strings.HasPrefix(subject, userSubjectPrefix) {
+		return 0, false
+	}
+	value, err := strconv.ParseUint(strings.TrimPrefix(subject, userSubjectPrefix), 10, 32)
+	if err != nil || value == 0 {
+		return 0, false
+	}
+	return uint(value), true
+}
+
+// IsActiveAdmin indica se o usuário é um administrador com a conta ativa
+func (u *User) IsActiveAdmin() bool {
+	return !u.Disabled && HasRole(u.Roles, RoleAdmin)
+}
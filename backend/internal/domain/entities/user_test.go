@@ -0,0 +1,48 @@
+package entities
+
+import "testing"
+
+func TestParseUserSubject(t *testing.T) {
+	tests := []struct {
+		subject string
+		wantID  uint
+		wantOK  bool
+	}{
+		{subject: "user:42", wantID: 42, wantOK: true},
+		{subject: UserSubject(7), wantID: 7, wantOK: true},
+		{subject: "user:0", wantOK: false},
+		{subject: "user:-1", wantOK: false},
+		{subject: "user:abc", wantOK: false},
+		{subject: "user:", wantOK: false},
+		{subject: "api_key:3", wantOK: false},
+		{subject: "bootstrap", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		id, ok := ParseUserSubject(tt.subject)
+		if id != tt.wantID || ok != tt.wantOK {
+			t.Errorf("ParseUserSubject(%q) = %d, %v, esperado %d, %v", tt.subject, id, ok, tt.wantID, tt.wantOK)
+		}
+	}
+}
+
+func TestIsActiveAdmin(t *testing.T) {
+	tests := []struct {
+		name string
+		user User
+		want bool
+	}{
+		{name: "admin ativo", user: User{Roles: []Role{RoleEditor, RoleAdmin}}, want: true},
+		{name: "admin desativado", user: User{Roles: []Role{RoleAdmin}, Disabled: true}, want: false},
+		{name: "editor", user: User{Roles: []Role{RoleEditor}}, want: false},
+		{name: "sem papéis", user: User{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.user.IsActiveAdmin(); got != tt.want {
+				t.Errorf("IsActiveAdmin() = %v, esperado %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// ProductOption representa um eixo de variação de um produto (ex: Tamanho: P, M, G)
+type ProductOption struct {
+	ID        uint     `json:"id"`
+	ProductID uint     `json:"product_id"`
+	Name      string   `json:"name"`
+	Position  int      `json:"position"`
+	Values    []string `json:"values"`
+}
+
+// ProductVariant representa uma combinação de opções de um produto com SKU, preço, imagem e estoque próprios
+type ProductVariant struct {
+	ID        uint              `json:"id"`
+	ProductID uint              `json:"product_id"`
+	SKU       string            `json:"sku"`
+	Options   map[string]string `json:"options"`
+	// Price sobrescreve o preço do produto quando informado
+	Price         *Money    `json:"price"`
+	Image         string    `json:"image"`
+	StockQuantity int64     `json:"stock_quantity"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// OptionKey retorna uma chave canônica da combinação de opções (ex: "cor=azul;tamanho=m"),
+// usada para garantir que cada combinação exista uma única vez por produto
+func (v ProductVariant) OptionKey() string {
+	parts := make([]string, 0, len(v.Options))
+	for name, value := range v.Options {
+		parts = append(parts, strings.ToLower(name)+"="+strings.ToLower(value))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ";")
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import "catalogo-produtos/backend/internal/domain/entities"
+
+// APIKeyRepository define as operações de persistência para chaves de API
+type APIKeyRepository interface {
+	Create(key *entities.APIKey) error
+	GetByID(id uint) (*entities.APIKey, error)
+	GetByHash(hash string) (*entities.APIKey, error)
+	GetAll() ([]entities.APIKey, error)
+	// Update persiste os campos informados ("name", "scopes", "key", "expires_at", "last_used_at",
+	// "revoked_at"); sem campos, persiste todos
+	Update(key *entities.APIKey, fields ...string) error
+}
@@ -0,0 +1,9 @@
+package repositories
+
+import "catalogo-produtos/backend/internal/domain/entities"
+
+// AttributeRepository define as operações de persistência para os atributos de categorias
+type AttributeRepository interface {
+	GetByCategoryIDs(categoryIDs []uint) ([]entities.CategoryAttribute, error)
+	ReplaceForCategory(categoryID uint, attributes []entities.CategoryAttribute) error
+}
@@ -6,7 +6,27 @@ import "catalogo-produtos/backend/internal/domain/entities"
 type CategoryRepository interface {
 	Create(category *entities.Category) error
 	GetByID(id uint) (*entities.Category, error)
+	// GetByName busca uma categoria pelo nome exato, sem diferenciar maiúsculas
+	GetByName(name string) (*entities.Category, error)
 	GetAll() ([]entities.Category, error)
-	Update(category *entities.Category) error
+	// Update persiste os campos informados ("name", "parent_id"); sem campos, persiste todos.
+	// Ao mudar a categoria pai, retorna ErrCategoryNotFound se ela não existir, ou ErrParentIsDescendant
+	// se ela for uma subcategoria da própria categoria
+	Update(category *entities.Category, fields ...string) error
 	Delete(id uint) error
+	GetAncestors(id uint) ([]entities.Category, error)
+	GetDescendantIDs(id uint) ([]uint, error)
+	// Os métodos de remoção abaixo só removem a categoria se ela ainda estiver na versão
+	// informada; caso contrário retornam ErrVersionConflict
+	DeleteIfUnused(id uint, version int64) (*CategoryUsage, error)
+	// ReassignAndDelete retorna ErrTargetNotFound se a categoria destino não existir, ou
+	// ErrTargetIsDescendant se ela for uma subcategoria da removida
+	ReassignAndDelete(id, targetID uint, version int64) error
+	DeleteCascade(id uint, version int64) error
+}
+
+// CategoryUsage representa quantos registros ainda referenciam uma categoria
+type CategoryUsage struct {
+	Products      int64
+	Subcategories int64
 }
@@ -0,0 +1,41 @@
+package repositories
+
+import "errors"
+
+var (
+	// ErrDuplicateKey indica que a operação violou uma restrição de unicidade
+	ErrDuplicateKey = errors.New("registro duplicado")
+	// ErrInsufficientStock indica que a movimentação deixaria o estoque negativo
+	ErrInsufficientStock = errors.New("estoque insuficiente")
+	// ErrVersionConflict indica que o registro foi alterado desde a versão lida
+	ErrVersionConflict = errors.New("registro alterado por outra operação")
+	// ErrCategoryNotFound indica que a categoria referenciada (a do produto ou a categoria pai) não existe
+	// ou foi removida durante a gravação
+	ErrCategoryNotFound = errors.New("categoria não encontrada")
+	// ErrTargetNotFound indica que a categoria destino de uma realocação não existe
+	ErrTargetNotFound = errors.New("categoria destino não encontrada")
+	// ErrTargetIsDescendant indica que a categoria destino é uma subcategoria da removida
+	ErrTargetIsDescendant = errors.New("categoria destino é uma subcategoria da removida")
+	// ErrParentIsDescendant indica que a nova categoria pai é uma subcategoria da categoria movida
+	ErrParentIsDescendant = errors.New("categoria pai é uma subcategoria da própria categoria")
+	// ErrLastAdmin indica que a alteração deixaria o catálogo sem administradores ativos
+	ErrLastAdmin = errors.New("último administrador ativo")
+)
+
+// DuplicateKeyError é a violação de unicidade de um campo (ex: "sku"); corresponde a ErrDuplicateKey
+type DuplicateKeyError struct {
+	// Field é o campo em conflito, ou vazio se a restrição não corresponder a um campo conhecido
+	Field string
+}
+
+func (e *DuplicateKeyError) Error() string {
+	if e.Field == "" {
+		return ErrDuplicateKey.Error()
+	}
+	return ErrDuplicateKey.Error() + ": " + e.Field
+}
+
+// Is faz errors.Is(err, ErrDuplicateKey) reconhecer a violação
+func (e *DuplicateKeyError) Is(target error) bool {
+	return target == ErrDuplicateKey
+}
@@ -1,18 +1,84 @@
 package repositories
 
-import "catalogo-produtos/backend/internal/domain/entities"
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"time"
+)
 
 // ProductRepository define as operações de persistência para produtos
 type ProductRepository interface {
 	Create(product *entities.Product) error
 	GetByID(id uint) (*entities.Product, error)
-	GetAll(filters *ProductFilter) ([]entities.Product, error)
-	Update(product *entities.Product) error
-	Delete(id uint) error
+	GetBySKU(sku string) (*entities.Product, error)
+	GetBySlug(slug string) (*entities.Product, error)
+	GetByName(name string) (*entities.Product, error)
+	GetAll(filters *ProductFilter) ([]entities.Product, int64, error)
+	// GetByCategoryIDs busca até limit produtos de cada categoria, ordenados por ID, em uma única consulta
+	GetByCategoryIDs(categoryIDs []uint, limit int) ([]entities.Product, error)
+	// Stream percorre todos os produtos filtrados, sem paginação, lendo os registros do cursor do banco
+	Stream(filters *ProductFilter, fn func(product *entities.Product) error) error
+	// Update persiste os campos informados (nomes da API, ex: "price"); sem campos, persiste todos
+	Update(product *entities.Product, fields ...string) error
+	// Delete remove o produto se ele ainda estiver na versão informada; caso contrário retorna ErrVersionConflict
+	Delete(id uint, version int64) error
 }
 
 // ProductFilter define os filtros para busca de produtos
 type ProductFilter struct {
-	Name     string
-	Category string
+	Query       string
+	Name        string
+	Category    string
+	CategoryIDs []uint
+	// IncludeSubcategories expande CategoryIDs para incluir todas as subcategorias
+	IncludeSubcategories bool
+	// Currency restringe os produtos à moeda informada
+	Currency string
+	// MinPrice e MaxPrice só comparam produtos na moeda do próprio filtro
+	MinPrice     *entities.Money
+	MaxPrice     *entities.Money
+	CreatedAfter *time.Time
+	UpdatedAfter *time.Time
+	HasImage     *bool
+	Attributes   []AttributeFilter
+	Page         int
+	PageSize     int
+	Sort         []SortField
+}
+
+// AttributeFilter define um filtro por atributo (ex: ram_gb >= 8)
+type AttributeFilter struct {
+	Key      string
+	Operator string
+	Value    string
+}
+
+// Operadores aceitos nos filtros por atributo
+const (
+	AttributeOpEqual        = "="
+	AttributeOpNotEqual     = "!="
+	AttributeOpGreater      = ">"
+	AttributeOpGreaterEqual = ">="
+	AttributeOpLess         = "<"
+	AttributeOpLessEqual    = "<="
+)
+
+// SortField define um campo de ordenação e sua direção
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+// Valores padrão e limites de paginação
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// ProductSortFields lista os campos aceitos para ordenação de produtos
+var ProductSortFields = map[string]bool{
+	"id":         true,
+	"name":       true,
+	"price":      true,
+	"created_at": true,
+	"updated_at": true,
 }
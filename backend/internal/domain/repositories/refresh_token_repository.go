@@ -0,0 +1,16 @@
+package repositories
+
+import "catalogo-produtos/backend/internal/domain/entities"
+
+// RefreshTokenRepository define as operações de persistência para refresh tokens
+type RefreshTokenRepository interface {
+	Create(token *entities.RefreshToken) error
+	GetByHash(hash string) (*entities.RefreshToken, error)
+	// Rotate revoga o token atual e cria o próximo da mesma família atomicamente. Retorna
+	// ErrVersionConflict se o token atual já tiver sido revogado por outra operação.
+	Rotate(currentID uint, next *entities.RefreshToken) error
+	// RevokeFamily revoga todos os tokens ainda válidos da família
+	RevokeFamily(familyID string) error
+	// RevokeSubject revoga todos os tokens ainda válidos do subject, em todas as famílias
+	RevokeSubject(subject string) error
+}
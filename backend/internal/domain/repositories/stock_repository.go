@@ -0,0 +1,11 @@
+package repositories
+
+import "catalogo-produtos/backend/internal/domain/entities"
+
+// StockRepository define as operações de persistência para o estoque de produtos
+type StockRepository interface {
+	// RecordMovement aplica a movimentação ao estoque do produto e a registra no livro-razão
+	// atomicamente. Retorna ErrInsufficientStock se o saldo ficaria negativo.
+	RecordMovement(movement *entities.StockMovement) error
+	GetMovements(productID uint, page, pageSize int) ([]entities.StockMovement, int64, error)
+}
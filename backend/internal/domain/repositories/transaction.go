@@ -0,0 +1,17 @@
+package repositories
+
+// Repositories agrupa os repositórios que participam de uma mesma transação
+type Repositories struct {
+	Products   ProductRepository
+	Categories CategoryRepository
+	Attributes AttributeRepository
+	// Transactor abre transações aninhadas (savepoints) dentro da transação atual
+	Transactor Transactor
+}
+
+// Transactor executa operações de vários repositórios em uma única transação
+type Transactor interface {
+	// WithinTransaction executa fn com repositórios ligados à mesma transação;
+	// se fn retornar erro, todas as alterações são desfeitas
+	WithinTransaction(fn func(repos Repositories) error) error
+}
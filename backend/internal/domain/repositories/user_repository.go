@@ -0,0 +1,16 @@
+package repositories
+
+import "catalogo-produtos/backend/internal/domain/entities"
+
+// UserRepository define as operações de persistência para usuários
+type UserRepository interface {
+	Create(user *entities.User) error
+	GetByID(id uint) (*entities.User, error)
+	// GetByEmail busca um usuário pelo e-mail, já normalizado em minúsculas
+	GetByEmail(email string) (*entities.User, error)
+	GetAll() ([]entities.User, error)
+	// Update persiste os campos informados ("name", "roles", "password", "disabled", "last_login_at");
+	// sem campos, persiste todos. Retorna ErrLastAdmin se a alteração deixaria o catálogo sem
+	// administradores ativos.
+	Update(user *entities.User, fields ...string) error
+}
@@ -0,0 +1,14 @@
+package repositories
+
+import "catalogo-produtos/backend/internal/domain/entities"
+
+// VariantRepository define as operações de persistência para opções e variantes de produtos
+type VariantRepository interface {
+	ReplaceOptions(productID uint, options []entities.ProductOption) error
+	Create(variant *entities.ProductVariant) error
+	GetByID(id uint) (*entities.ProductVariant, error)
+	GetBySKU(sku string) (*entities.ProductVariant, error)
+	GetByProductID(productID uint) ([]entities.ProductVariant, error)
+	Update(variant *entities.ProductVariant) error
+	Delete(id uint) error
+}
@@ -0,0 +1,232 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// apiKeyPrefix identifica as chaves de API do catálogo (ex: em ferramentas de detecção de segredos)
+const apiKeyPrefix = "cpk_"
+
+// apiKeyDisplayLength é o tamanho do início da chave guardado para identificá-la nas listagens
+const apiKeyDisplayLength = 12
+
+// lastUsedInterval limita a frequência com que o último uso de uma chave é gravado
+const lastUsedInterval = time.Minute
+
+// APIKeyInput representa os dados de uma nova chave de API
+type APIKeyInput struct {
+	Name   string
+	Scopes []entities.Scope
+	// ExpiresAt é o fim da validade; nil cria uma chave sem expiração
+	ExpiresAt *time.Time
+}
+
+// Erros de chaves de API
+var (
+	// ErrAPIKeyNotFound indica que a chave de API não existe
+	ErrAPIKeyNotFound = NewNotFoundError("api_key_not_found", "chave de API não encontrada")
+	// ErrInvalidAPIKey indica uma chave desconhecida, expirada ou revogada
+	ErrInvalidAPIKey = NewUnauthenticatedError("invalid_api_key", "chave de API inválida, expirada ou revogada")
+
+	errAPIKeyNameRequired = invalidField("name_required", "name", "nome é obrigatório")
+	errScopesRequired     = invalidField("scopes_required", "scopes", "informe ao menos um escopo")
+	errExpiresInPast      = invalidField("invalid_expiration", "expires_at", "a expiração deve estar no futuro")
+	errAPIKeyRevoked      = NewConflictError("api_key_revoked", "a chave de API foi revogada")
+)
+
+// APIKeyUseCase define os casos de uso de chaves de API para integrações
+type APIKeyUseCase interface {
+	// CreateAPIKey cria uma chave e retorna também a chave em si, exibida apenas uma vez
+	CreateAPIKey(ctx context.Context, input APIKeyInput) (*entities.APIKey, string, error)
+	GetAPIKey(ctx context.Context, id uint) (*entities.APIKey, error)
+	GetAPIKeys(ctx context.Context) ([]entities.APIKey, error)
+	// RotateAPIKey substitui a chave, mantendo nome, escopos e expiração; a anterior deixa de valer
+	RotateAPIKey(ctx context.Context, id uint) (*entities.APIKey, string, error)
+	RevokeAPIKey(ctx context.Context, id uint) (*entities.APIKey, error)
+	// Authenticate valida uma chave e retorna as claims da requisição, com os escopos da chave
+	Authenticate(key string) (*entities.TokenClaims, error)
+}
+
+// apiKeyUseCase implementa APIKeyUseCase
+type apiKeyUseCase struct {
+	apiKeyRepo repositories.APIKeyRepository
+	authorizer Authorizer
+}
+
+// NewAPIKeyUseCase cria uma nova instância de APIKeyUseCase
+func NewAPIKeyUseCase(apiKeyRepo repositories.APIKeyRepository, authorizer Authorizer) APIKeyUseCase {
+	return &apiKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		authorizer: authorizer,
+	}
+}
+
+// CreateAPIKey gera uma chave aleatória e armazena seu hash
+func (uc *apiKeyUseCase) CreateAPIKey(ctx context.Context, input APIKeyInput) (*entities.APIKey, string, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionAPIKeysManage); err != nil {
+		return nil, "", err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, "", errAPIKeyNameRequired
+	}
+	scopes, err := normalizeScopes(input.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return nil, "", errExpiresInPast
+	}
+
+	key := &entities.APIKey{
+		Name:      name,
+		Scopes:    scopes,
+		ExpiresAt: input.ExpiresAt,
+	}
+	if claims := ClaimsFromContext(ctx); claims != nil {
+		key.CreatedBy = claims.Subject
+	}
+
+	secret, err := newAPIKeySecret(key)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := uc.apiKeyRepo.Create(key); err != nil {
+		return nil, "", err
+	}
+	return key, secret, nil
+}
+
+// GetAPIKey busca uma chave por ID
+func (uc *apiKeyUseCase) GetAPIKey(ctx context.Context, id uint) (*entities.APIKey, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionAPIKeysManage); err != nil {
+		return nil, err
+	}
+
+	key, err := uc.apiKeyRepo.GetByID(id)
+	if err != nil {
+		return nil, ErrAPIKeyNotFound
+	}
+	return key, nil
+}
+
+// GetAPIKeys busca todas as chaves, inclusive as revogadas e expiradas
+func (uc *apiKeyUseCase) GetAPIKeys(ctx context.Context) ([]entities.APIKey, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionAPIKeysManage); err != nil {
+		return nil, err
+	}
+	return uc.apiKeyRepo.GetAll()
+}
+
+// RotateAPIKey gera uma nova chave para o mesmo registro
+func (uc *apiKeyUseCase) RotateAPIKey(ctx context.Context, id uint) (*entities.APIKey, string, error) {
+	key, err := uc.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if key.RevokedAt != nil {
+		return nil, "", errAPIKeyRevoked
+	}
+
+	secret, err := newAPIKeySecret(key)
+	if err != nil {
+		return nil, "", err
+	}
+	key.LastUsedAt = nil
+	if err := uc.apiKeyRepo.Update(key, "key", "last_used_at"); err != nil {
+		return nil, "", err
+	}
+	return key, secret, nil
+}
+
+// RevokeAPIKey revoga a chave; revogar uma chave já revogada não a altera
+func (uc *apiKeyUseCase) RevokeAPIKey(ctx context.Context, id uint) (*entities.APIKey, error) {
+	key, err := uc.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if key.RevokedAt != nil {
+		return key, nil
+	}
+
+	now := time.Now()
+	key.RevokedAt = &now
+	if err := uc.apiKeyRepo.Update(key, "revoked_at"); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Authenticate busca a chave pelo hash e registra o uso
+func (uc *apiKeyUseCase) Authenticate(secret string) (*entities.TokenClaims, error) {
+	if !strings.HasPrefix(secret, apiKeyPrefix) {
+		return nil, ErrInvalidAPIKey
+	}
+	key, err := uc.apiKeyRepo.GetByHash(hashToken(secret))
+	if err != nil {
+		return nil, ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	if !key.IsActive(now) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	// Gravar o último uso no máximo uma vez por intervalo, e não a cada requisição
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedInterval {
+		key.LastUsedAt = &now
+		if err := uc.apiKeyRepo.Update(key, "last_used_at"); err != nil {
+			log.Printf("Erro ao registrar o uso da chave de API %d: %v", key.ID, err)
+		}
+	}
+
+	claims := &entities.TokenClaims{
+		Subject: entities.APIKeySubject(key.ID),
+		Scopes:  key.Scopes,
+		TokenID: key.Prefix,
+	}
+	if key.ExpiresAt != nil {
+		claims.ExpiresAt = *key.ExpiresAt
+	}
+	return claims, nil
+}
+
+// newAPIKeySecret gera uma chave aleatória e grava no registro o prefixo exibido e o hash.
+// Como nos refresh tokens, a chave é longa e aleatória, então um hash rápido basta.
+func newAPIKeySecret(key *entities.APIKey) (string, error) {
+	random, err := randomToken(32)
+	if err != nil {
+		return "", err
+	}
+	secret := apiKeyPrefix + random
+	key.Prefix = secret[:apiKeyDisplayLength]
+	key.KeyHash = hashToken(secret)
+	return secret, nil
+}
+
+// normalizeScopes valida os escopos e remove repetições
+func normalizeScopes(scopes []entities.Scope) ([]entities.Scope, error) {
+	if len(scopes) == 0 {
+		return nil, errScopesRequired
+	}
+
+	seen := make(map[entities.Scope]bool, len(scopes))
+	result := make([]entities.Scope, 0, len(scopes))
+	for _, scope := range scopes {
+		if !scope.IsValid() {
+			return nil, invalidField("invalid_scope", "scopes", fmt.Sprintf("escopo inválido: '%s'", scope))
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			result = append(result, scope)
+		}
+	}
+	return result, nil
+}
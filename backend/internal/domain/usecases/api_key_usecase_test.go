@@ -0,0 +1,249 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// memoryAPIKeyRepository guarda as chaves de API em memória
+type memoryAPIKeyRepository struct {
+	keys map[uint]entities.APIKey
+}
+
+func (r *memoryAPIKeyRepository) Create(key *entities.APIKey) error {
+	key.ID = uint(len(r.keys) + 1)
+	r.keys[key.ID] = *key
+	return nil
+}
+
+func (r *memoryAPIKeyRepository) GetByID(id uint) (*entities.APIKey, error) {
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &key, nil
+}
+
+func (r *memoryAPIKeyRepository) GetByHash(hash string) (*entities.APIKey, error) {
+	for _, key := range r.keys {
+		if key.KeyHash == hash {
+			return &key, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (r *memoryAPIKeyRepository) GetAll() ([]entities.APIKey, error) {
+	var keys []entities.APIKey
+	for _, key := range r.keys {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (r *memoryAPIKeyRepository) Update(key *entities.APIKey, fields ...string) error {
+	r.keys[key.ID] = *key
+	return nil
+}
+
+func TestCreateAPIKeyStoresOnlyTheHash(t *testing.T) {
+	repo := &memoryAPIKeyRepository{keys: map[uint]entities.APIKey{}}
+	uc := NewAPIKeyUseCase(repo, NewRoleAuthorizer())
+
+	key, secret, err := uc.CreateAPIKey(contextForUser(1, entities.RoleAdmin), APIKeyInput{
+		Name:   " ERP ",
+		Scopes: []entities.Scope{entities.ScopeStockWrite, entities.ScopeStockWrite},
+	})
+	if err != nil {
+		t.Fatalf("CreateAPIKey retornou erro: %v", err)
+	}
+
+	stored := repo.keys[key.ID]
+	sum := sha256.Sum256([]byte(secret))
+	if stored.KeyHash != hex.EncodeToString(sum[:]) {
+		t.Errorf("KeyHash = %q, esperado o SHA-256 da chave", stored.KeyHash)
+	}
+	if !strings.HasPrefix(secret, "cpk_") || len(secret) < 40 {
+		t.Errorf("chave = %q, esperado cpk_ seguido de 32 bytes aleatórios", secret)
+	}
+	if stored.Prefix != secret[:12] {
+		t.Errorf("Prefix = %q, esperado o início da chave", stored.Prefix)
+	}
+	if stored.Name != "ERP" || !reflect.DeepEqual(stored.Scopes, []entities.Scope{entities.ScopeStockWrite}) {
+		t.Errorf("chave armazenada = %+v, esperado nome sem espaços e escopos sem repetição", stored)
+	}
+	if stored.CreatedBy != "user:1" {
+		t.Errorf("CreatedBy = %q, esperado user:1", stored.CreatedBy)
+	}
+
+	_, other, err := uc.CreateAPIKey(contextForUser(1, entities.RoleAdmin), APIKeyInput{Name: "ERP", Scopes: []entities.Scope{entities.ScopeStockWrite}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == secret {
+		t.Error("duas chaves geradas são iguais")
+	}
+}
+
+func TestCreateAPIKeyValidation(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name     string
+		input    APIKeyInput
+		wantCode string
+	}{
+		{name: "sem nome", input: APIKeyInput{Name: " ", Scopes: []entities.Scope{entities.ScopeStockWrite}}, wantCode: "name_required"},
+		{name: "sem escopos", input: APIKeyInput{Name: "ERP"}, wantCode: "scopes_required"},
+		{name: "escopo inválido", input: APIKeyInput{Name: "ERP", Scopes: []entities.Scope{"users:manage"}}, wantCode: "invalid_scope"},
+		{name: "expiração no passado", input: APIKeyInput{Name: "ERP", Scopes: []entities.Scope{entities.ScopeStockWrite}, ExpiresAt: &past}, wantCode: "invalid_expiration"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryAPIKeyRepository{keys: map[uint]entities.APIKey{}}
+			uc := NewAPIKeyUseCase(repo, NewRoleAuthorizer())
+
+			_, _, err := uc.CreateAPIKey(contextForUser(1, entities.RoleAdmin), tt.input)
+			var ucErr *Error
+			if !errors.As(err, &ucErr) || ucErr.Code != tt.wantCode {
+				t.Fatalf("erro = %v, esperado %s", err, tt.wantCode)
+			}
+			if len(repo.keys) != 0 {
+				t.Error("chave inválida foi criada")
+			}
+		})
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	tests := []struct {
+		name string
+		// present cria a chave e retorna o valor apresentado na requisição
+		present    func(t *testing.T, uc APIKeyUseCase, repo *memoryAPIKeyRepository) string
+		wantScopes []entities.Scope
+		wantErr    bool
+	}{
+		{
+			name: "chave válida",
+			present: func(t *testing.T, uc APIKeyUseCase, repo *memoryAPIKeyRepository) string {
+				return createKey(t, uc, nil)
+			},
+			wantScopes: []entities.Scope{entities.ScopeProductsWrite},
+		},
+		{
+			name: "chave que expira no futuro",
+			present: func(t *testing.T, uc APIKeyUseCase, repo *memoryAPIKeyRepository) string {
+				future := time.Now().Add(time.Hour)
+				return createKey(t, uc, &future)
+			},
+			wantScopes: []entities.Scope{entities.ScopeProductsWrite},
+		},
+		{
+			name: "chave expirada",
+			present: func(t *testing.T, uc APIKeyUseCase, repo *memoryAPIKeyRepository) string {
+				future := time.Now().Add(time.Hour)
+				secret := createKey(t, uc, &future)
+				key := repo.keys[1]
+				expired := time.Now().Add(-time.Second)
+				key.ExpiresAt = &expired
+				repo.keys[1] = key
+				return secret
+			},
+			wantErr: true,
+		},
+		{
+			name: "chave revogada",
+			present: func(t *testing.T, uc APIKeyUseCase, repo *memoryAPIKeyRepository) string {
+				secret := createKey(t, uc, nil)
+				if _, err := uc.RevokeAPIKey(contextForUser(1, entities.RoleAdmin), 1); err != nil {
+					t.Fatal(err)
+				}
+				return secret
+			},
+			wantErr: true,
+		},
+		{
+			name: "chave substituída na rotação",
+			present: func(t *testing.T, uc APIKeyUseCase, repo *memoryAPIKeyRepository) string {
+				secret := createKey(t, uc, nil)
+				if _, _, err := uc.RotateAPIKey(contextForUser(1, entities.RoleAdmin), 1); err != nil {
+					t.Fatal(err)
+				}
+				return secret
+			},
+			wantErr: true,
+		},
+		{
+			name: "chave nova da rotação",
+			present: func(t *testing.T, uc APIKeyUseCase, repo *memoryAPIKeyRepository) string {
+				createKey(t, uc, nil)
+				_, secret, err := uc.RotateAPIKey(contextForUser(1, entities.RoleAdmin), 1)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return secret
+			},
+			wantScopes: []entities.Scope{entities.ScopeProductsWrite},
+		},
+		{
+			name: "sem o prefixo",
+			present: func(t *testing.T, uc APIKeyUseCase, repo *memoryAPIKeyRepository) string {
+				return strings.TrimPrefix(createKey(t, uc, nil), "cpk_")
+			},
+			wantErr: true,
+		},
+		{
+			name: "chave desconhecida",
+			present: func(t *testing.T, uc APIKeyUseCase, repo *memoryAPIKeyRepository) string {
+				createKey(t, uc, nil)
+				return "cpk_desconhecida"
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryAPIKeyRepository{keys: map[uint]entities.APIKey{}}
+			uc := NewAPIKeyUseCase(repo, NewRoleAuthorizer())
+
+			claims, err := uc.Authenticate(tt.present(t, uc, repo))
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidAPIKey) {
+					t.Fatalf("erro = %v, esperado invalid_api_key", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate retornou erro: %v", err)
+			}
+			if claims.Subject != "api_key:1" || !reflect.DeepEqual(claims.Scopes, tt.wantScopes) || len(claims.Roles) != 0 {
+				t.Errorf("claims = %+v, esperado api_key:1 com os escopos %v e sem papéis", claims, tt.wantScopes)
+			}
+			if repo.keys[1].LastUsedAt == nil {
+				t.Error("o uso da chave não foi registrado")
+			}
+		})
+	}
+}
+
+// createKey cria uma chave com o escopo products:write e retorna a chave em si
+func createKey(t *testing.T, uc APIKeyUseCase, expiresAt *time.Time) string {
+	t.Helper()
+	_, secret, err := uc.CreateAPIKey(contextForUser(1, entities.RoleAdmin), APIKeyInput{
+		Name:      "Marketplace",
+		Scopes:    []entities.Scope{entities.ScopeProductsWrite},
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("CreateAPIKey retornou erro: %v", err)
+	}
+	return secret
+}
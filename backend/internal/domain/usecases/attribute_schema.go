@@ -0,0 +1,161 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// attributeKeyPattern define o formato das chaves de atributo (ex: ram_gb)
+var attributeKeyPattern = regexp.MustCompile(`^[a-z0-9_]{1,64}$`)
+
+// invalidAttribute indica que um atributo do produto não segue o schema da categoria
+func invalidAttribute(key, message string) *Error {
+	return NewValidationError("invalid_attribute", fmt.Sprintf("atributo '%s': %s", key, message),
+		FieldError{Field: "attributes." + key, Message: message})
+}
+
+// resolveSchema monta o schema efetivo de uma categoria: atributos herdados dos
+// ancestrais seguidos dos próprios, com a categoria mais específica prevalecendo
+func resolveSchema(categoryRepo repositories.CategoryRepository, attributeRepo repositories.AttributeRepository, categoryID uint) ([]entities.CategoryAttribute, error) {
+	ancestors, err := categoryRepo.GetAncestors(categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryIDs := make([]uint, 0, len(ancestors)+1)
+	for _, ancestor := range ancestors {
+		categoryIDs = append(categoryIDs, ancestor.ID)
+	}
+	categoryIDs = append(categoryIDs, categoryID)
+
+	attributes, err := attributeRepo.GetByCategoryIDs(categoryIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Agrupar por categoria para aplicar da raiz até a própria categoria
+	byCategory := make(map[uint][]entities.CategoryAttribute)
+	for _, attribute := range attributes {
+		byCategory[attribute.CategoryID] = append(byCategory[attribute.CategoryID], attribute)
+	}
+
+	schema := []entities.CategoryAttribute{}
+	index := make(map[string]int)
+	for _, id := range categoryIDs {
+		for _, attribute := range byCategory[id] {
+			if i, ok := index[attribute.Key]; ok {
+				schema[i] = attribute
+				continue
+			}
+			index[attribute.Key] = len(schema)
+			schema = append(schema, attribute)
+		}
+	}
+
+	return schema, nil
+}
+
+// validateAttributeDefinitions valida e normaliza as definições de atributos de uma categoria
+func validateAttributeDefinitions(attributes []entities.CategoryAttribute) error {
+	seen := make(map[string]bool)
+	for i := range attributes {
+		attribute := &attributes[i]
+		attribute.Key = strings.TrimSpace(attribute.Key)
+		attribute.Label = strings.TrimSpace(attribute.Label)
+
+		if !attributeKeyPattern.MatchString(attribute.Key) {
+			return invalidField("invalid_attribute_definition", fmt.Sprintf("attributes[%d].key", i),
+				fmt.Sprintf("chave de atributo inválida '%s': use letras minúsculas, números e '_'", attribute.Key))
+		}
+		if seen[attribute.Key] {
+			return invalidField("invalid_attribute_definition", fmt.Sprintf("attributes[%d].key", i),
+				fmt.Sprintf("atributo '%s' informado mais de uma vez", attribute.Key))
+		}
+		seen[attribute.Key] = true
+
+		if attribute.Label == "" {
+			attribute.Label = attribute.Key
+		}
+
+		switch attribute.Type {
+		case entities.AttributeString, entities.AttributeNumber, entities.AttributeBoolean:
+			attribute.Options = nil
+		case entities.AttributeEnum:
+			if len(attribute.Options) == 0 {
+				return invalidField("invalid_attribute_definition", fmt.Sprintf("attributes[%d].options", i),
+					fmt.Sprintf("atributo '%s' do tipo enum deve ter opções", attribute.Key))
+			}
+		default:
+			return invalidField("invalid_attribute_definition", fmt.Sprintf("attributes[%d].type", i),
+				fmt.Sprintf("tipo inválido para o atributo '%s': %s", attribute.Key, attribute.Type))
+		}
+	}
+
+	return nil
+}
+
+// validateAttributes verifica os valores de um produto contra o schema efetivo da categoria.
+// Chaves desconhecidas são rejeitadas e números inteiros em JSON chegam como float64.
+func validateAttributes(schema []entities.CategoryAttribute, values map[string]interface{}) error {
+	known := make(map[string]bool, len(schema))
+	for _, attribute := range schema {
+		known[attribute.Key] = true
+
+		value, ok := values[attribute.Key]
+		if !ok || value == nil {
+			if attribute.Required {
+				return invalidAttribute(attribute.Key, "é obrigatório")
+			}
+			delete(values, attribute.Key)
+			continue
+		}
+
+		if err := validateAttributeValue(attribute, value); err != nil {
+			return err
+		}
+	}
+
+	for key := range values {
+		if !known[key] {
+			return invalidAttribute(key, "não é definido pela categoria")
+		}
+	}
+
+	return nil
+}
+
+// validateAttributeValue verifica se o valor corresponde ao tipo do atributo
+func validateAttributeValue(attribute entities.CategoryAttribute, value interface{}) error {
+	switch attribute.Type {
+	case entities.AttributeNumber:
+		if _, ok := value.(float64); !ok {
+			return invalidAttribute(attribute.Key, "deve ser um número")
+		}
+	case entities.AttributeBoolean:
+		if _, ok := value.(bool); !ok {
+			return invalidAttribute(attribute.Key, "deve ser verdadeiro ou falso")
+		}
+	case entities.AttributeString:
+		if _, ok := value.(string); !ok {
+			return invalidAttribute(attribute.Key, "deve ser um texto")
+		}
+	case entities.AttributeEnum:
+		text, ok := value.(string)
+		if !ok {
+			return invalidAttribute(attribute.Key, "deve ser um texto")
+		}
+		for _, option := range attribute.Options {
+			if option == text {
+				return nil
+			}
+		}
+		return invalidAttribute(attribute.Key, fmt.Sprintf("deve ser um de: %s", strings.Join(attribute.Options, ", ")))
+	default:
+		return errors.New("tipo de atributo desconhecido")
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// stubCategoryRepository implementa apenas a busca de ancestrais
+type stubCategoryRepository struct {
+	repositories.CategoryRepository
+	ancestors map[uint][]entities.Category
+}
+
+func (r *stubCategoryRepository) GetAncestors(id uint) ([]entities.Category, error) {
+	return r.ancestors[id], nil
+}
+
+// stubAttributeRepository implementa apenas a busca dos atributos das categorias
+type stubAttributeRepository struct {
+	repositories.AttributeRepository
+	attributes []entities.CategoryAttribute
+}
+
+func (r *stubAttributeRepository) GetByCategoryIDs(categoryIDs []uint) ([]entities.CategoryAttribute, error) {
+	var result []entities.CategoryAttribute
+	for _, attribute := range r.attributes {
+		for _, id := range categoryIDs {
+			if attribute.CategoryID == id {
+				result = append(result, attribute)
+			}
+		}
+	}
+	return result, nil
+}
+
+func TestResolveSchema(t *testing.T) {
+	categoryRepo := &stubCategoryRepository{ancestors: map[uint][]entities.Category{
+		3: {{ID: 1}, {ID: 2}},
+	}}
+	attributeRepo := &stubAttributeRepository{attributes: []entities.CategoryAttribute{
+		{CategoryID: 3, Key: "ram_gb", Type: entities.AttributeNumber, Required: true},
+		{CategoryID: 1, Key: "marca", Type: entities.AttributeString},
+		{CategoryID: 1, Key: "garantia", Type: entities.AttributeNumber},
+		{CategoryID: 2, Key: "garantia", Type: entities.AttributeEnum, Options: []string{"12", "24"}},
+	}}
+
+	schema, err := resolveSchema(categoryRepo, attributeRepo, 3)
+	if err != nil {
+		t.Fatalf("resolveSchema retornou erro: %v", err)
+	}
+
+	// Herdados primeiro, na ordem da raiz; a definição mais específica prevalece
+	want := []struct {
+		key        string
+		categoryID uint
+	}{{"marca", 1}, {"garantia", 2}, {"ram_gb", 3}}
+	if len(schema) != len(want) {
+		t.Fatalf("schema com %d atributos, esperado %d: %+v", len(schema), len(want), schema)
+	}
+	for i, w := range want {
+		if schema[i].Key != w.key || schema[i].CategoryID != w.categoryID {
+			t.Errorf("schema[%d] = %s da categoria %d, esperado %s da categoria %d",
+				i, schema[i].Key, schema[i].CategoryID, w.key, w.categoryID)
+		}
+	}
+}
+
+func TestValidateAttributeDefinitions(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes []entities.CategoryAttribute
+		want       []entities.CategoryAttribute
+		wantField  string
+	}{
+		{
+			name: "normaliza chave, rótulo e opções",
+			attributes: []entities.CategoryAttribute{
+				{Key: " ram_gb ", Type: entities.AttributeNumber, Options: []string{"x"}},
+				{Key: "cor", Label: " Cor ", Type: entities.AttributeEnum, Options: []string{"azul"}},
+			},
+			want: []entities.CategoryAttribute{
+				{Key: "ram_gb", Label: "ram_gb", Type: entities.AttributeNumber},
+				{Key: "cor", Label: "Cor", Type: entities.AttributeEnum, Options: []string{"azul"}},
+			},
+		},
+		{
+			name:       "chave com maiúsculas",
+			attributes: []entities.CategoryAttribute{{Key: "RamGB", Type: entities.AttributeNumber}},
+			wantField:  "attributes[0].key",
+		},
+		{
+			name:       "chave vazia",
+			attributes: []entities.CategoryAttribute{{Key: " ", Type: entities.AttributeString}},
+			wantField:  "attributes[0].key",
+		},
+		{
+			name: "chave repetida",
+			attributes: []entities.CategoryAttribute{
+				{Key: "cor", Type: entities.AttributeString},
+				{Key: "cor", Type: entities.AttributeString},
+			},
+			wantField: "attributes[1].key",
+		},
+		{
+			name:       "enum sem opções",
+			attributes: []entities.CategoryAttribute{{Key: "cor", Type: entities.AttributeEnum}},
+			wantField:  "attributes[0].options",
+		},
+		{
+			name:       "tipo desconhecido",
+			attributes: []entities.CategoryAttribute{{Key: "peso", Type: "decimal"}},
+			wantField:  "attributes[0].type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAttributeDefinitions(tt.attributes)
+			if tt.wantField != "" {
+				var ucErr *Error
+				if !errors.As(err, &ucErr) || len(ucErr.Fields) != 1 || ucErr.Fields[0].Field != tt.wantField {
+					t.Fatalf("erro = %#v, esperado erro no campo %s", err, tt.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("validateAttributeDefinitions retornou erro: %v", err)
+			}
+			if !reflect.DeepEqual(tt.attributes, tt.want) {
+				t.Errorf("atributos = %+v, esperado %+v", tt.attributes, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateAttributes(t *testing.T) {
+	schema := []entities.CategoryAttribute{
+		{Key: "ram_gb", Type: entities.AttributeNumber, Required: true},
+		{Key: "marca", Type: entities.AttributeString},
+		{Key: "ssd", Type: entities.AttributeBoolean},
+		{Key: "cor", Type: entities.AttributeEnum, Options: []string{"preto", "prata"}},
+	}
+
+	tests := []struct {
+		name   string
+		values map[string]interface{}
+		// want são os valores depois da validação, que remove os opcionais nulos
+		want      map[string]interface{}
+		wantField string
+	}{
+		{
+			name:   "todos válidos",
+			values: map[string]interface{}{"ram_gb": float64(16), "marca": "Dell", "ssd": true, "cor": "prata"},
+			want:   map[string]interface{}{"ram_gb": float64(16), "marca": "Dell", "ssd": true, "cor": "prata"},
+		},
+		{
+			name:   "opcional nulo é removido",
+			values: map[string]interface{}{"ram_gb": float64(8), "marca": nil},
+			want:   map[string]interface{}{"ram_gb": float64(8)},
+		},
+		{name: "obrigatório ausente", values: map[string]interface{}{"marca": "Dell"}, wantField: "attributes.ram_gb"},
+		{name: "obrigatório nulo", values: map[string]interface{}{"ram_gb": nil}, wantField: "attributes.ram_gb"},
+		{name: "número como texto", values: map[string]interface{}{"ram_gb": "16"}, wantField: "attributes.ram_gb"},
+		{name: "booleano como texto", values: map[string]interface{}{"ram_gb": float64(8), "ssd": "sim"}, wantField: "attributes.ssd"},
+		{name: "texto como número", values: map[string]interface{}{"ram_gb": float64(8), "marca": float64(1)}, wantField: "attributes.marca"},
+		{name: "opção fora do enum", values: map[string]interface{}{"ram_gb": float64(8), "cor": "azul"}, wantField: "attributes.cor"},
+		{name: "enum não textual", values: map[string]interface{}{"ram_gb": float64(8), "cor": true}, wantField: "attributes.cor"},
+		{name: "chave desconhecida", values: map[string]interface{}{"ram_gb": float64(8), "peso": float64(2)}, wantField: "attributes.peso"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAttributes(schema, tt.values)
+			if tt.wantField != "" {
+				var ucErr *Error
+				if !errors.As(err, &ucErr) || ucErr.Code != "invalid_attribute" || ucErr.Fields[0].Field != tt.wantField {
+					t.Fatalf("erro = %#v, esperado invalid_attribute em %s", err, tt.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("validateAttributes retornou erro: %v", err)
+			}
+			if !reflect.DeepEqual(tt.values, tt.want) {
+				t.Errorf("valores = %v, esperado %v", tt.values, tt.want)
+			}
+		})
+	}
+}
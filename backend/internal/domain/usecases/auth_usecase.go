@@ -0,0 +1,218 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+)
+
+// TokenSigner emite e valida tokens de acesso assinados (JWT)
+type TokenSigner interface {
+	// Sign emite um token de acesso para o subject com as claims adicionais
+	Sign(subject string, claims map[string]interface{}) (token string, expiresAt time.Time, err error)
+	// Verify valida assinatura, emissor, audiência e expiração do token
+	Verify(token string) (*entities.TokenClaims, error)
+}
+
+// AuthUseCase define os casos de uso de autenticação: tokens de acesso de curta duração e
+// refresh tokens rotacionados a cada uso
+type AuthUseCase interface {
+	// IssueTokens inicia uma nova sessão (família de refresh tokens) para o subject
+	IssueTokens(subject string, claims map[string]interface{}) (*entities.TokenPair, error)
+	// Refresh troca um refresh token válido por um novo par de tokens. O token usado é revogado;
+	// reutilizá-lo revoga a sessão inteira, pois indica que ele vazou.
+	Refresh(refreshToken string) (*entities.TokenPair, error)
+	// Revoke encerra a sessão do refresh token, revogando todos os tokens da família
+	Revoke(refreshToken string) error
+	// RevokeSubject encerra todas as sessões do subject (ex: ao desativar uma conta)
+	RevokeSubject(subject string) error
+	// Authenticate valida um token de acesso. Para usuários, vale o estado atual da conta: conta
+	// desativada é rejeitada e os papéis são os atuais, não os das claims.
+	Authenticate(accessToken string) (*entities.TokenClaims, error)
+}
+
+// Erros de autenticação
+var (
+	// ErrAuthenticationRequired indica uma operação protegida sem token de acesso
+	ErrAuthenticationRequired = NewUnauthenticatedError("authentication_required", "autenticação necessária")
+	// ErrInvalidToken indica um token de acesso malformado, com assinatura inválida ou expirado
+	ErrInvalidToken = NewUnauthenticatedError("invalid_token", "token de acesso inválido ou expirado")
+
+	errInvalidRefreshToken = NewUnauthenticatedError("invalid_refresh_token", "refresh token inválido, expirado ou revogado")
+	errRefreshTokenReused  = NewUnauthenticatedError("refresh_token_reused", "refresh token já utilizado; a sessão foi encerrada")
+)
+
+// claimsKey é a chave das claims do token no contexto da requisição
+type claimsKey struct{}
+
+// ContextWithClaims adiciona as claims do token autenticado ao contexto
+func ContextWithClaims(ctx context.Context, claims *entities.TokenClaims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext obtém as claims do token autenticado, ou nil se a requisição não foi autenticada
+func ClaimsFromContext(ctx context.Context) *entities.TokenClaims {
+	claims, _ := ctx.Value(claimsKey{}).(*entities.TokenClaims)
+	return claims
+}
+
+// authUseCase implementa AuthUseCase
+type authUseCase struct {
+	signer           TokenSigner
+	refreshTokenRepo repositories.RefreshTokenRepository
+	userRepo         repositories.UserRepository
+	refreshTokenTTL  time.Duration
+}
+
+// NewAuthUseCase cria uma nova instância de AuthUseCase
+func NewAuthUseCase(signer TokenSigner, refreshTokenRepo repositories.RefreshTokenRepository, userRepo repositories.UserRepository, refreshTokenTTL time.Duration) AuthUseCase {
+	return &authUseCase{
+		signer:           signer,
+		refreshTokenRepo: refreshTokenRepo,
+		userRepo:         userRepo,
+		refreshTokenTTL:  refreshTokenTTL,
+	}
+}
+
+// IssueTokens emite um token de acesso e o primeiro refresh token de uma nova família
+func (uc *authUseCase) IssueTokens(subject string, claims map[string]interface{}) (*entities.TokenPair, error) {
+	familyID, err := randomToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, secret, err := uc.newRefreshToken(familyID, subject, claims)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.refreshTokenRepo.Create(refreshToken); err != nil {
+		return nil, err
+	}
+	return uc.tokenPair(refreshToken, secret)
+}
+
+// Refresh rotaciona o refresh token e emite um novo token de acesso
+func (uc *authUseCase) Refresh(secret string) (*entities.TokenPair, error) {
+	current, err := uc.refreshTokenRepo.GetByHash(hashToken(secret))
+	if err != nil {
+		return nil, errInvalidRefreshToken
+	}
+	if current.RevokedAt != nil {
+		return nil, uc.revokeReused(current)
+	}
+	if !time.Now().Before(current.ExpiresAt) {
+		return nil, errInvalidRefreshToken
+	}
+
+	next, nextSecret, err := uc.newRefreshToken(current.FamilyID, current.Subject, current.Claims)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.refreshTokenRepo.Rotate(current.ID, next); err != nil {
+		if errors.Is(err, repositories.ErrVersionConflict) {
+			// Outra requisição usou o mesmo token ao mesmo tempo
+			return nil, uc.revokeReused(current)
+		}
+		return nil, err
+	}
+	return uc.tokenPair(next, nextSecret)
+}
+
+// Revoke revoga a família do refresh token. Tokens desconhecidos são ignorados, para que o
+// logout seja idempotente.
+func (uc *authUseCase) Revoke(secret string) error {
+	token, err := uc.refreshTokenRepo.GetByHash(hashToken(secret))
+	if err != nil {
+		return nil
+	}
+	return uc.refreshTokenRepo.RevokeFamily(token.FamilyID)
+}
+
+// RevokeSubject revoga os refresh tokens de todas as famílias do subject
+func (uc *authUseCase) RevokeSubject(subject string) error {
+	return uc.refreshTokenRepo.RevokeSubject(subject)
+}
+
+// Authenticate valida o token de acesso. Os tokens de usuários são conferidos com a conta, para
+// que desativá-la ou alterar seus papéis valha já, sem esperar o token expirar.
+func (uc *authUseCase) Authenticate(accessToken string) (*entities.TokenClaims, error) {
+	claims, err := uc.signer.Verify(accessToken)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	id, ok := entities.ParseUserSubject(claims.Subject)
+	if !ok {
+		return claims, nil
+	}
+	user, err := uc.userRepo.GetByID(id)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	if user.Disabled {
+		return nil, errAccountDisabled
+	}
+	claims.Roles = user.Roles
+	return claims, nil
+}
+
+// revokeReused revoga a família de um refresh token apresentado depois de já ter sido usado
+func (uc *authUseCase) revokeReused(token *entities.RefreshToken) error {
+	log.Printf("Refresh token reutilizado (família %s, subject %s); sessão revogada", token.FamilyID, token.Subject)
+	if err := uc.refreshTokenRepo.RevokeFamily(token.FamilyID); err != nil {
+		return err
+	}
+	return errRefreshTokenReused
+}
+
+// newRefreshToken gera um refresh token aleatório; retorna o registro, com o hash, e o token em si
+func (uc *authUseCase) newRefreshToken(familyID, subject string, claims map[string]interface{}) (*entities.RefreshToken, string, error) {
+	secret, err := randomToken(32)
+	if err != nil {
+		return nil, "", err
+	}
+	return &entities.RefreshToken{
+		FamilyID:  familyID,
+		Subject:   subject,
+		TokenHash: hashToken(secret),
+		Claims:    claims,
+		ExpiresAt: time.Now().Add(uc.refreshTokenTTL),
+	}, secret, nil
+}
+
+// tokenPair emite o token de acesso que acompanha o refresh token
+func (uc *authUseCase) tokenPair(refreshToken *entities.RefreshToken, secret string) (*entities.TokenPair, error) {
+	accessToken, expiresAt, err := uc.signer.Sign(refreshToken.Subject, refreshToken.Claims)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.TokenPair{
+		AccessToken:      accessToken,
+		AccessExpiresAt:  expiresAt,
+		RefreshToken:     secret,
+		RefreshExpiresAt: refreshToken.ExpiresAt,
+	}, nil
+}
+
+// randomToken gera um valor aleatório de size bytes, codificado em base64 para URLs
+func randomToken(size int) (string, error) {
+	buf := make([]byte, size)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashToken calcula o hash armazenado de um refresh token. Os tokens são aleatórios e longos,
+// então um hash rápido basta.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
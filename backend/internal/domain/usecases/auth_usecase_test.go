@@ -0,0 +1,295 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// memoryRefreshTokenRepository guarda os refresh tokens em memória
+type memoryRefreshTokenRepository struct {
+	tokens []*entities.RefreshToken
+	// rotateConflict simula outra renovação concorrente que revogou o token antes
+	rotateConflict bool
+}
+
+func (r *memoryRefreshTokenRepository) Create(token *entities.RefreshToken) error {
+	token.ID = uint(len(r.tokens) + 1)
+	r.tokens = append(r.tokens, token)
+	return nil
+}
+
+func (r *memoryRefreshTokenRepository) GetByHash(hash string) (*entities.RefreshToken, error) {
+	for _, token := range r.tokens {
+		if token.TokenHash == hash {
+			return token, nil
+		}
+	}
+	return nil, errors.New("not found")
+}
+
+func (r *memoryRefreshTokenRepository) Rotate(currentID uint, next *entities.RefreshToken) error {
+	current := r.tokens[currentID-1]
+	if r.rotateConflict || current.RevokedAt != nil {
+		return repositories.ErrVersionConflict
+	}
+	now := time.Now()
+	current.RevokedAt = &now
+	if err := r.Create(next); err != nil {
+		return err
+	}
+	current.ReplacedByID = &next.ID
+	return nil
+}
+
+func (r *memoryRefreshTokenRepository) RevokeFamily(familyID string) error {
+	return r.revoke(func(token *entities.RefreshToken) bool { return token.FamilyID == familyID })
+}
+
+func (r *memoryRefreshTokenRepository) RevokeSubject(subject string) error {
+	return r.revoke(func(token *entities.RefreshToken) bool { return token.Subject == subject })
+}
+
+func (r *memoryRefreshTokenRepository) revoke(match func(*entities.RefreshToken) bool) error {
+	now := time.Now()
+	for _, token := range r.tokens {
+		if match(token) && token.RevokedAt == nil {
+			token.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+// active conta os tokens ainda não revogados da família
+func (r *memoryRefreshTokenRepository) active(familyID string) int {
+	count := 0
+	for _, token := range r.tokens {
+		if token.FamilyID == familyID && token.RevokedAt == nil {
+			count++
+		}
+	}
+	return count
+}
+
+// fakeSigner emite tokens de acesso legíveis ("access:<subject>") e valida os que emitiu
+type fakeSigner struct{}
+
+func (fakeSigner) Sign(subject string, claims map[string]interface{}) (string, time.Time, error) {
+	return "access:" + subject, time.Now().Add(time.Minute), nil
+}
+
+func (fakeSigner) Verify(token string) (*entities.TokenClaims, error) {
+	subject, ok := strings.CutPrefix(token, "access:")
+	if !ok {
+		return nil, errors.New("token inválido")
+	}
+	return &entities.TokenClaims{Subject: subject, Roles: []entities.Role{entities.RoleAdmin}}, nil
+}
+
+// stubUserRepository implementa apenas a busca por ID
+type stubUserRepository struct {
+	repositories.UserRepository
+	users map[uint]*entities.User
+}
+
+func (r *stubUserRepository) GetByID(id uint) (*entities.User, error) {
+	if user, ok := r.users[id]; ok {
+		return user, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func TestRefresh(t *testing.T) {
+	tests := []struct {
+		name string
+		// present emite a sessão e retorna o refresh token apresentado na renovação
+		present            func(t *testing.T, uc AuthUseCase, repo *memoryRefreshTokenRepository) string
+		wantCode           string
+		wantFamilyRevoked  bool
+		wantActiveInFamily int
+	}{
+		{
+			name: "token válido é rotacionado",
+			present: func(t *testing.T, uc AuthUseCase, repo *memoryRefreshTokenRepository) string {
+				return issue(t, uc).RefreshToken
+			},
+			wantActiveInFamily: 1,
+		},
+		{
+			name: "token já usado revoga a sessão",
+			present: func(t *testing.T, uc AuthUseCase, repo *memoryRefreshTokenRepository) string {
+				first := issue(t, uc).RefreshToken
+				if _, err := uc.Refresh(first); err != nil {
+					t.Fatalf("primeira renovação falhou: %v", err)
+				}
+				return first
+			},
+			wantCode:          "refresh_token_reused",
+			wantFamilyRevoked: true,
+		},
+		{
+			name: "token antigo da cadeia revoga a sessão",
+			present: func(t *testing.T, uc AuthUseCase, repo *memoryRefreshTokenRepository) string {
+				first := issue(t, uc).RefreshToken
+				second, err := uc.Refresh(first)
+				if err != nil {
+					t.Fatalf("primeira renovação falhou: %v", err)
+				}
+				if _, err := uc.Refresh(second.RefreshToken); err != nil {
+					t.Fatalf("segunda renovação falhou: %v", err)
+				}
+				return first
+			},
+			wantCode:          "refresh_token_reused",
+			wantFamilyRevoked: true,
+		},
+		{
+			name: "renovação concorrente revoga a sessão",
+			present: func(t *testing.T, uc AuthUseCase, repo *memoryRefreshTokenRepository) string {
+				token := issue(t, uc).RefreshToken
+				repo.rotateConflict = true
+				return token
+			},
+			wantCode:          "refresh_token_reused",
+			wantFamilyRevoked: true,
+		},
+		{
+			name: "token encerrado por logout",
+			present: func(t *testing.T, uc AuthUseCase, repo *memoryRefreshTokenRepository) string {
+				token := issue(t, uc).RefreshToken
+				if err := uc.Revoke(token); err != nil {
+					t.Fatalf("Revoke retornou erro: %v", err)
+				}
+				return token
+			},
+			wantCode:          "refresh_token_reused",
+			wantFamilyRevoked: true,
+		},
+		{
+			name: "token expirado",
+			present: func(t *testing.T, uc AuthUseCase, repo *memoryRefreshTokenRepository) string {
+				token := issue(t, uc).RefreshToken
+				repo.tokens[0].ExpiresAt = time.Now().Add(-time.Second)
+				return token
+			},
+			wantCode:           "invalid_refresh_token",
+			wantActiveInFamily: 1,
+		},
+		{
+			name: "token desconhecido",
+			present: func(t *testing.T, uc AuthUseCase, repo *memoryRefreshTokenRepository) string {
+				issue(t, uc)
+				return "desconhecido"
+			},
+			wantCode:           "invalid_refresh_token",
+			wantActiveInFamily: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryRefreshTokenRepository{}
+			uc := NewAuthUseCase(fakeSigner{}, repo, &stubUserRepository{}, time.Hour)
+
+			secret := tt.present(t, uc, repo)
+			familyID := repo.tokens[0].FamilyID
+			pair, err := uc.Refresh(secret)
+
+			if tt.wantCode != "" {
+				var ucErr *Error
+				if !errors.As(err, &ucErr) || ucErr.Code != tt.wantCode {
+					t.Fatalf("erro = %v, esperado %s", err, tt.wantCode)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Refresh retornou erro: %v", err)
+				}
+				if pair.RefreshToken == secret || pair.AccessToken == "" {
+					t.Errorf("Refresh = %+v, esperado um novo par de tokens", pair)
+				}
+			}
+
+			active := repo.active(familyID)
+			if tt.wantFamilyRevoked && active != 0 {
+				t.Errorf("%d tokens da sessão continuam ativos, esperado nenhum", active)
+			}
+			if !tt.wantFamilyRevoked && active != tt.wantActiveInFamily {
+				t.Errorf("%d tokens ativos na sessão, esperado %d", active, tt.wantActiveInFamily)
+			}
+		})
+	}
+}
+
+func TestRefreshKeepsOtherSessions(t *testing.T) {
+	repo := &memoryRefreshTokenRepository{}
+	uc := NewAuthUseCase(fakeSigner{}, repo, &stubUserRepository{}, time.Hour)
+
+	stolen := issue(t, uc).RefreshToken
+	other := issue(t, uc).RefreshToken
+	if _, err := uc.Refresh(stolen); err != nil {
+		t.Fatalf("Refresh retornou erro: %v", err)
+	}
+	if _, err := uc.Refresh(stolen); err == nil {
+		t.Fatal("reutilização do refresh token foi aceita")
+	}
+
+	// A reutilização encerra apenas a sessão do token reutilizado
+	if _, err := uc.Refresh(other); err != nil {
+		t.Errorf("a outra sessão do subject foi encerrada: %v", err)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	users := &stubUserRepository{users: map[uint]*entities.User{
+		1: {ID: 1, Roles: []entities.Role{entities.RoleEditor}},
+		2: {ID: 2, Roles: []entities.Role{entities.RoleAdmin}, Disabled: true},
+	}}
+
+	tests := []struct {
+		name      string
+		token     string
+		wantRoles []entities.Role
+		wantCode  string
+	}{
+		{name: "papéis atuais do usuário", token: "access:user:1", wantRoles: []entities.Role{entities.RoleEditor}},
+		{name: "conta desativada", token: "access:user:2", wantCode: "account_disabled"},
+		{name: "usuário inexistente", token: "access:user:3", wantCode: "invalid_token"},
+		{name: "integração mantém os papéis do token", token: "access:bootstrap", wantRoles: []entities.Role{entities.RoleAdmin}},
+		{name: "token inválido", token: "invalido", wantCode: "invalid_token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewAuthUseCase(fakeSigner{}, &memoryRefreshTokenRepository{}, users, time.Hour)
+
+			claims, err := uc.Authenticate(tt.token)
+			if tt.wantCode != "" {
+				var ucErr *Error
+				if !errors.As(err, &ucErr) || ucErr.Code != tt.wantCode {
+					t.Fatalf("erro = %v, esperado %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate retornou erro: %v", err)
+			}
+			if !reflect.DeepEqual(claims.Roles, tt.wantRoles) {
+				t.Errorf("Roles = %v, esperado %v", claims.Roles, tt.wantRoles)
+			}
+		})
+	}
+}
+
+// issue inicia uma sessão de teste
+func issue(t *testing.T, uc AuthUseCase) *entities.TokenPair {
+	t.Helper()
+	pair, err := uc.IssueTokens("user:1", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("IssueTokens retornou erro: %v", err)
+	}
+	return pair
+}
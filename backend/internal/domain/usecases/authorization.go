@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"context"
+	"fmt"
+)
+
+// Authorizer decide se quem solicitou uma operação, identificado pelas claims do contexto,
+// tem a permissão necessária. Os casos de uso o consultam antes de toda escrita, de modo que
+// as mesmas regras valem para qualquer ponto de entrada (HTTP, GraphQL, gRPC, linha de
+// comando, jobs). Processos sem usuário colocam no contexto claims com o papel adequado.
+type Authorizer interface {
+	Authorize(ctx context.Context, permission entities.Permission) error
+}
+
+// NewPermissionDeniedError cria o erro de uma operação sem a permissão necessária, que a
+// identifica em Details
+func NewPermissionDeniedError(permission entities.Permission) *Error {
+	return &Error{
+		Kind:    KindPermissionDenied,
+		Code:    "permission_denied",
+		Message: fmt.Sprintf("permissão necessária: %s", permission),
+		Details: map[string]interface{}{
+			"missing_permission": string(permission),
+		},
+	}
+}
+
+// roleAuthorizer implementa Authorizer a partir dos papéis do token ou dos escopos da chave de API
+type roleAuthorizer struct{}
+
+// NewRoleAuthorizer cria um Authorizer que concede as permissões dos papéis e escopos das claims
+func NewRoleAuthorizer() Authorizer {
+	return roleAuthorizer{}
+}
+
+// Authorize exige claims no contexto com um papel ou escopo que conceda a permissão
+func (roleAuthorizer) Authorize(ctx context.Context, permission entities.Permission) error {
+	claims := ClaimsFromContext(ctx)
+	if claims == nil {
+		return ErrAuthenticationRequired
+	}
+	if !entities.HasPermission(claims.Roles, permission) && !entities.ScopesAllow(claims.Scopes, permission) {
+		return NewPermissionDeniedError(permission)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRoleAuthorizer(t *testing.T) {
+	withClaims := func(claims *entities.TokenClaims) context.Context {
+		return ContextWithClaims(context.Background(), claims)
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		permission entities.Permission
+		wantCode   string
+	}{
+		{name: "sem claims", ctx: context.Background(), permission: entities.PermissionProductsCreate, wantCode: "authentication_required"},
+		{name: "papel concede", ctx: contextWithRoles(entities.RoleEditor), permission: entities.PermissionProductsUpdate},
+		{name: "papel não concede", ctx: contextWithRoles(entities.RoleEditor), permission: entities.PermissionProductsDelete, wantCode: "permission_denied"},
+		{name: "sem papéis", ctx: contextWithRoles(), permission: entities.PermissionStockWrite, wantCode: "permission_denied"},
+		{
+			name:       "escopo concede",
+			ctx:        withClaims(&entities.TokenClaims{Subject: "api_key:1", Scopes: []entities.Scope{entities.ScopeStockWrite}}),
+			permission: entities.PermissionStockWrite,
+		},
+		{
+			name:       "escopo não concede",
+			ctx:        withClaims(&entities.TokenClaims{Subject: "api_key:1", Scopes: []entities.Scope{entities.ScopeStockWrite}}),
+			permission: entities.PermissionProductsUpdate,
+			wantCode:   "permission_denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewRoleAuthorizer().Authorize(tt.ctx, tt.permission)
+			if tt.wantCode == "" {
+				if err != nil {
+					t.Fatalf("Authorize retornou erro: %v", err)
+				}
+				return
+			}
+
+			var ucErr *Error
+			if !errors.As(err, &ucErr) || ucErr.Code != tt.wantCode {
+				t.Fatalf("erro = %v, esperado %s", err, tt.wantCode)
+			}
+			if tt.wantCode == "permission_denied" && ucErr.Details["missing_permission"] != string(tt.permission) {
+				t.Errorf("Details = %v, esperado a permissão %s", ucErr.Details, tt.permission)
+			}
+		})
+	}
+}
@@ -3,56 +3,132 @@ package usecases
 import (
 	"catalogo-produtos/backend/internal/domain/entities"
 	"catalogo-produtos/backend/internal/domain/repositories"
+	"context"
 	"errors"
+	"fmt"
 )
 
+// DeleteMode define como tratar produtos e subcategorias ao remover uma categoria
+type DeleteMode string
+
+// Modos de remoção de categorias
+const (
+	// DeleteModeRestrict recusa a remoção se a categoria estiver em uso
+	DeleteModeRestrict DeleteMode = "restrict"
+	// DeleteModeReassign move produtos e subcategorias para outra categoria
+	DeleteModeReassign DeleteMode = "reassign"
+	// DeleteModeCascade remove também as subcategorias e seus produtos
+	DeleteModeCascade DeleteMode = "cascade"
+)
+
+// DeleteCategoryOptions define as opções de remoção de uma categoria
+type DeleteCategoryOptions struct {
+	Mode     DeleteMode
+	TargetID uint
+	// Version é a versão esperada da categoria; obrigatória, AnyVersion aceita qualquer versão
+	Version int64
+}
+
+// CategoryPatch representa uma atualização parcial de uma categoria (JSON Merge Patch)
+type CategoryPatch struct {
+	Name *string
+	// ParentSet indica que parent_id foi enviado; com ParentID nil a categoria vira raiz
+	ParentSet bool
+	ParentID  *uint
+	// Version é a versão esperada da categoria; obrigatória, AnyVersion aceita qualquer versão
+	Version int64
+}
+
+// errNameRequired indica que a categoria não tem nome
+var errNameRequired = invalidField("name_required", "name", "nome é obrigatório")
+
+// categoryInUse indica que a categoria ainda possui produtos ou subcategorias
+func categoryInUse(usage *repositories.CategoryUsage) *Error {
+	return &Error{
+		Kind:    KindConflict,
+		Code:    "category_in_use",
+		Message: fmt.Sprintf("categoria em uso por %d produto(s) e %d subcategoria(s)", usage.Products, usage.Subcategories),
+		Details: map[string]interface{}{
+			"products":      usage.Products,
+			"subcategories": usage.Subcategories,
+		},
+	}
+}
+
 // CategoryUseCase define os casos de uso para categorias
 type CategoryUseCase interface {
-	CreateCategory(name string) (*entities.Category, error)
+	CreateCategory(ctx context.Context, name string, parentID *uint) (*entities.Category, error)
 	GetCategory(id uint) (*entities.Category, error)
 	GetCategories() ([]entities.Category, error)
-	UpdateCategory(id uint, name string) (*entities.Category, error)
-	DeleteCategory(id uint) error
+	GetCategoryTree() ([]entities.CategoryNode, error)
+	// UpdateCategory substitui nome e pai se a categoria ainda estiver na versão informada (AnyVersion aceita qualquer versão)
+	UpdateCategory(ctx context.Context, id uint, name string, parentID *uint, version int64) (*entities.Category, error)
+	PatchCategory(ctx context.Context, id uint, patch CategoryPatch) (*entities.Category, []string, error)
+	DeleteCategory(ctx context.Context, id uint, options DeleteCategoryOptions) error
+	GetAttributes(id uint) ([]entities.CategoryAttribute, error)
+	SetAttributes(ctx context.Context, id uint, attributes []entities.CategoryAttribute) ([]entities.CategoryAttribute, error)
 }
 
 // categoryUseCase implementa CategoryUseCase
 type categoryUseCase struct {
-	categoryRepo repositories.CategoryRepository
+	categoryRepo  repositories.CategoryRepository
+	attributeRepo repositories.AttributeRepository
+	authorizer    Authorizer
 }
 
 // NewCategoryUseCase cria uma nova instância de CategoryUseCase
-func NewCategoryUseCase(categoryRepo repositories.CategoryRepository) CategoryUseCase {
+func NewCategoryUseCase(categoryRepo repositories.CategoryRepository, attributeRepo repositories.AttributeRepository, authorizer Authorizer) CategoryUseCase {
 	return &categoryUseCase{
-		categoryRepo: categoryRepo,
+		categoryRepo:  categoryRepo,
+		attributeRepo: attributeRepo,
+		authorizer:    authorizer,
 	}
 }
 
 // CreateCategory cria uma nova categoria
-func (uc *categoryUseCase) CreateCategory(name string) (*entities.Category, error) {
+func (uc *categoryUseCase) CreateCategory(ctx context.Context, name string, parentID *uint) (*entities.Category, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionCategoriesCreate); err != nil {
+		return nil, err
+	}
+
 	// Validar nome
 	if name == "" {
-		return nil, errors.New("nome é obrigatório")
+		return nil, errNameRequired
+	}
+
+	// Validar se a categoria pai existe
+	if parentID != nil {
+		if _, err := uc.categoryRepo.GetByID(*parentID); err != nil {
+			return nil, errUnknownParent
+		}
 	}
 
 	category := &entities.Category{
-		Name: name,
+		Name:     name,
+		ParentID: parentID,
 	}
 
 	err := uc.categoryRepo.Create(category)
 	if err != nil {
+		if errors.Is(err, repositories.ErrDuplicateKey) {
+			return nil, fieldInUse("name", name)
+		}
+		if errors.Is(err, repositories.ErrCategoryNotFound) {
+			return nil, errUnknownParent
+		}
 		return nil, err
 	}
 
-	return category, nil
+	return uc.withAncestors(category)
 }
 
 // GetCategory busca uma categoria por ID
 func (uc *categoryUseCase) GetCategory(id uint) (*entities.Category, error) {
 	category, err := uc.categoryRepo.GetByID(id)
 	if err != nil {
-		return nil, errors.New("categoria não encontrada")
+		return nil, ErrCategoryNotFound
 	}
-	return category, nil
+	return uc.withAncestors(category)
 }
 
 // GetCategories busca todas as categorias
@@ -61,40 +137,285 @@ func (uc *categoryUseCase) GetCategories() ([]entities.Category, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	// Montar os ancestrais em memória a partir da lista completa
+	byID := make(map[uint]entities.Category, len(categories))
+	for _, category := range categories {
+		byID[category.ID] = category
+	}
+	for i := range categories {
+		categories[i].Ancestors = ancestorsFromMap(byID, categories[i])
+	}
+
 	return categories, nil
 }
 
+// GetCategoryTree monta a árvore de categorias a partir das categorias raiz
+func (uc *categoryUseCase) GetCategoryTree() ([]entities.CategoryNode, error) {
+	categories, err := uc.categoryRepo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[uint]bool, len(categories))
+	children := make(map[uint][]entities.Category)
+	for _, category := range categories {
+		known[category.ID] = true
+	}
+
+	var roots []entities.Category
+	for _, category := range categories {
+		// Categorias cujo pai foi removido são tratadas como raiz
+		if category.ParentID == nil || !known[*category.ParentID] {
+			roots = append(roots, category)
+			continue
+		}
+		children[*category.ParentID] = append(children[*category.ParentID], category)
+	}
+
+	var build func(category entities.Category) entities.CategoryNode
+	build = func(category entities.Category) entities.CategoryNode {
+		node := entities.CategoryNode{Category: category, Children: []entities.CategoryNode{}}
+		for _, child := range children[category.ID] {
+			node.Children = append(node.Children, build(child))
+		}
+		return node
+	}
+
+	tree := make([]entities.CategoryNode, len(roots))
+	for i, root := range roots {
+		tree[i] = build(root)
+	}
+
+	return tree, nil
+}
+
 // UpdateCategory atualiza uma categoria
-func (uc *categoryUseCase) UpdateCategory(id uint, name string) (*entities.Category, error) {
+func (uc *categoryUseCase) UpdateCategory(ctx context.Context, id uint, name string, parentID *uint, version int64) (*entities.Category, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionCategoriesUpdate); err != nil {
+		return nil, err
+	}
+
 	// Buscar categoria existente
 	category, err := uc.categoryRepo.GetByID(id)
 	if err != nil {
-		return nil, errors.New("categoria não encontrada")
+		return nil, ErrCategoryNotFound
+	}
+	if err := checkVersion(version, category.Version); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.save(category, name, parentID); err != nil {
+		return nil, err
 	}
 
+	return uc.withAncestors(category)
+}
+
+// PatchCategory aplica uma atualização parcial e retorna os campos efetivamente alterados
+func (uc *categoryUseCase) PatchCategory(ctx context.Context, id uint, patch CategoryPatch) (*entities.Category, []string, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionCategoriesUpdate); err != nil {
+		return nil, nil, err
+	}
+
+	category, err := uc.categoryRepo.GetByID(id)
+	if err != nil {
+		return nil, nil, ErrCategoryNotFound
+	}
+	if err := checkVersion(patch.Version, category.Version); err != nil {
+		return nil, nil, err
+	}
+
+	name, parentID := category.Name, category.ParentID
+	if patch.Name != nil {
+		name = *patch.Name
+	}
+	if patch.ParentSet {
+		parentID = patch.ParentID
+	}
+
+	changed, err := uc.save(category, name, parentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	category, err = uc.withAncestors(category)
+	if err != nil {
+		return nil, nil, err
+	}
+	return category, changed, nil
+}
+
+// save valida os dados, aplica à categoria e persiste somente os campos alterados
+func (uc *categoryUseCase) save(category *entities.Category, name string, parentID *uint) ([]string, error) {
 	// Validar nome
 	if name == "" {
-		return nil, errors.New("nome é obrigatório")
+		return nil, errNameRequired
 	}
 
-	// Atualizar nome
-	category.Name = name
+	// Validar a nova categoria pai
+	if parentID != nil {
+		if err := validateParent(category.ID, *parentID); err != nil {
+			return nil, err
+		}
+	}
 
-	err = uc.categoryRepo.Update(category)
-	if err != nil {
+	changed := []string{}
+	if category.Name != name {
+		category.Name = name
+		changed = append(changed, "name")
+	}
+	if !sameParent(category.ParentID, parentID) {
+		category.ParentID = parentID
+		changed = append(changed, "parent_id")
+	}
+
+	if len(changed) == 0 {
+		return changed, nil
+	}
+
+	if err := uc.categoryRepo.Update(category, changed...); err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrVersionConflict):
+			return nil, ErrVersionMismatch
+		case errors.Is(err, repositories.ErrDuplicateKey):
+			return nil, fieldInUse("name", category.Name)
+		case errors.Is(err, repositories.ErrCategoryNotFound):
+			return nil, errUnknownParent
+		case errors.Is(err, repositories.ErrParentIsDescendant):
+			return nil, invalidField("invalid_parent_category", "parent_id", "a categoria pai não pode ser uma subcategoria da própria categoria")
+		}
 		return nil, err
 	}
 
-	return category, nil
+	return changed, nil
 }
 
-// DeleteCategory remove uma categoria
-func (uc *categoryUseCase) DeleteCategory(id uint) error {
+// sameParent compara duas referências opcionais de categoria pai
+func sameParent(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// DeleteCategory remove uma categoria conforme o modo informado
+func (uc *categoryUseCase) DeleteCategory(ctx context.Context, id uint, options DeleteCategoryOptions) error {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionCategoriesDelete); err != nil {
+		return err
+	}
+
 	// Verificar se a categoria existe
-	_, err := uc.categoryRepo.GetByID(id)
+	category, err := uc.categoryRepo.GetByID(id)
+	if err != nil {
+		return ErrCategoryNotFound
+	}
+	if err := checkVersion(options.Version, category.Version); err != nil {
+		return err
+	}
+
+	switch options.Mode {
+	case DeleteModeReassign:
+		if options.TargetID == id {
+			return invalidField("invalid_target_category", "target", "a categoria destino deve ser diferente da categoria removida")
+		}
+
+		// O destino é verificado na mesma transação que move os produtos
+		err := uc.categoryRepo.ReassignAndDelete(id, options.TargetID, category.Version)
+		switch {
+		case errors.Is(err, repositories.ErrTargetNotFound):
+			return invalidField("unknown_target_category", "target", "categoria destino não encontrada")
+		case errors.Is(err, repositories.ErrTargetIsDescendant):
+			// Subcategorias movidas para um descendente formariam um ciclo
+			return invalidField("invalid_target_category", "target", "a categoria destino não pode ser uma subcategoria da categoria removida")
+		}
+		return versionConflict(err)
+
+	case DeleteModeCascade:
+		return versionConflict(uc.categoryRepo.DeleteCascade(id, category.Version))
+
+	case DeleteModeRestrict, "":
+		usage, err := uc.categoryRepo.DeleteIfUnused(id, category.Version)
+		if err != nil {
+			return versionConflict(err)
+		}
+		if usage.Products > 0 || usage.Subcategories > 0 {
+			return categoryInUse(usage)
+		}
+		return nil
+
+	default:
+		return invalidField("invalid_delete_mode", "mode", "modo de remoção inválido")
+	}
+}
+
+// GetAttributes retorna o schema efetivo da categoria, incluindo os atributos herdados
+func (uc *categoryUseCase) GetAttributes(id uint) ([]entities.CategoryAttribute, error) {
+	if _, err := uc.categoryRepo.GetByID(id); err != nil {
+		return nil, ErrCategoryNotFound
+	}
+	return resolveSchema(uc.categoryRepo, uc.attributeRepo, id)
+}
+
+// SetAttributes substitui os atributos definidos pela própria categoria e retorna o schema efetivo
+func (uc *categoryUseCase) SetAttributes(ctx context.Context, id uint, attributes []entities.CategoryAttribute) ([]entities.CategoryAttribute, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionCategoriesUpdate); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.categoryRepo.GetByID(id); err != nil {
+		return nil, ErrCategoryNotFound
+	}
+
+	if err := validateAttributeDefinitions(attributes); err != nil {
+		return nil, err
+	}
+
+	if err := uc.attributeRepo.ReplaceForCategory(id, attributes); err != nil {
+		return nil, err
+	}
+
+	return resolveSchema(uc.categoryRepo, uc.attributeRepo, id)
+}
+
+// validateParent recusa uma categoria como pai de si mesma. A existência da categoria pai e o ciclo
+// com uma subcategoria são verificados pelo repositório, na mesma transação que a move.
+func validateParent(id, parentID uint) error {
+	if parentID == id {
+		return invalidField("invalid_parent_category", "parent_id", "uma categoria não pode ser pai de si mesma")
+	}
+	return nil
+}
+
+// withAncestors preenche os ancestrais da categoria
+func (uc *categoryUseCase) withAncestors(category *entities.Category) (*entities.Category, error) {
+	if category.ParentID == nil {
+		return category, nil
+	}
+
+	ancestors, err := uc.categoryRepo.GetAncestors(category.ID)
 	if err != nil {
-		return errors.New("categoria não encontrada")
+		return nil, err
+	}
+	category.Ancestors = ancestors
+
+	return category, nil
+}
+
+// ancestorsFromMap monta os ancestrais de uma categoria a partir de um mapa por ID
+func ancestorsFromMap(byID map[uint]entities.Category, category entities.Category) []entities.Category {
+	var ancestors []entities.Category
+	visited := map[uint]bool{category.ID: true}
+
+	for parentID := category.ParentID; parentID != nil; {
+		parent, ok := byID[*parentID]
+		if !ok || visited[parent.ID] {
+			break
+		}
+		visited[parent.ID] = true
+		ancestors = append([]entities.Category{parent}, ancestors...)
+		parentID = parent.ParentID
 	}
 
-	return uc.categoryRepo.Delete(id)
+	return ancestors
 }
@@ -0,0 +1,143 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"errors"
+	"fmt"
+)
+
+// ErrorKind classifica um erro de domínio; a camada de apresentação escolhe o status HTTP a partir dele
+type ErrorKind string
+
+// Tipos de erro de domínio
+const (
+	// KindNotFound indica que o recurso solicitado não existe
+	KindNotFound ErrorKind = "not_found"
+	// KindConflict indica que a operação conflita com o estado atual (ex: SKU em uso)
+	KindConflict ErrorKind = "conflict"
+	// KindValidation indica dados bem formados, mas que violam as regras do domínio
+	KindValidation ErrorKind = "validation"
+	// KindBadRequest indica uma requisição malformada (JSON inválido, parâmetro com formato errado)
+	KindBadRequest ErrorKind = "bad_request"
+	// KindPreconditionFailed indica que o recurso mudou desde a versão informada pelo cliente
+	KindPreconditionFailed ErrorKind = "precondition_failed"
+	// KindPreconditionRequired indica que a operação exige uma versão e nenhuma foi informada
+	KindPreconditionRequired ErrorKind = "precondition_required"
+	// KindAborted indica que a operação não foi aplicada porque outra operação da qual dependia falhou
+	KindAborted ErrorKind = "aborted"
+	// KindUnauthenticated indica que a operação exige autenticação e nenhum token válido foi informado
+	KindUnauthenticated ErrorKind = "unauthenticated"
+	// KindPermissionDenied indica que quem solicitou a operação não tem a permissão necessária
+	KindPermissionDenied ErrorKind = "permission_denied"
+)
+
+// FieldError detalha um campo inválido de um erro de validação
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error é um erro de domínio com um código estável, legível por máquina (ex: "sku_in_use")
+type Error struct {
+	Kind    ErrorKind
+	Code    string
+	Message string
+	// Fields lista os campos envolvidos, em erros de validação e de conflito
+	Fields []FieldError
+	// Details traz informações adicionais sobre o erro (ex: quantos produtos usam a categoria)
+	Details map[string]interface{}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// NewNotFoundError cria um erro de recurso inexistente
+func NewNotFoundError(code, message string) *Error {
+	return &Error{Kind: KindNotFound, Code: code, Message: message}
+}
+
+// NewConflictError cria um erro de conflito com o estado atual
+func NewConflictError(code, message string, fields ...FieldError) *Error {
+	return &Error{Kind: KindConflict, Code: code, Message: message, Fields: fields}
+}
+
+// NewValidationError cria um erro de validação com os campos inválidos
+func NewValidationError(code, message string, fields ...FieldError) *Error {
+	return &Error{Kind: KindValidation, Code: code, Message: message, Fields: fields}
+}
+
+// NewBadRequestError cria um erro de requisição malformada
+func NewBadRequestError(code, message string) *Error {
+	return &Error{Kind: KindBadRequest, Code: code, Message: message}
+}
+
+// NewUnauthenticatedError cria um erro de autenticação ausente ou inválida
+func NewUnauthenticatedError(code, message string) *Error {
+	return &Error{Kind: KindUnauthenticated, Code: code, Message: message}
+}
+
+// AnyVersion é a versão esperada que aceita qualquer versão do registro (If-Match: *)
+const AnyVersion int64 = -1
+
+// checkVersion compara a versão esperada pelo cliente com a versão atual do registro.
+// Escritas sempre exigem uma versão; AnyVersion dispensa a comparação.
+func checkVersion(expected, current int64) error {
+	switch expected {
+	case 0:
+		return ErrVersionRequired
+	case AnyVersion, current:
+		return nil
+	default:
+		return ErrVersionMismatch
+	}
+}
+
+// versionConflict converte o conflito de versão detectado pelo repositório em ErrVersionMismatch
+func versionConflict(err error) error {
+	if errors.Is(err, repositories.ErrVersionConflict) {
+		return ErrVersionMismatch
+	}
+	return err
+}
+
+// invalidField cria um erro de validação de um único campo, com a mensagem do erro também no campo
+func invalidField(code, field, message string) *Error {
+	return NewValidationError(code, message, FieldError{Field: field, Message: message})
+}
+
+// fieldInUse cria um erro de conflito para um valor único já usado por outro registro
+func fieldInUse(field, value string) *Error {
+	message := fmt.Sprintf("%s '%s' já está em uso", field, value)
+	return NewConflictError(field+"_in_use", message, FieldError{Field: field, Message: message})
+}
+
+// Erros de domínio compartilhados entre os casos de uso
+var (
+	// ErrProductNotFound indica que o produto não existe
+	ErrProductNotFound = NewNotFoundError("product_not_found", "produto não encontrado")
+	// ErrVariantNotFound indica que a variante não existe ou não pertence ao produto
+	ErrVariantNotFound = NewNotFoundError("variant_not_found", "variante não encontrada")
+	// ErrCategoryNotFound indica que a categoria não existe
+	ErrCategoryNotFound = NewNotFoundError("category_not_found", "categoria não encontrada")
+	// ErrVersionMismatch indica que o registro foi alterado desde a versão informada pelo cliente
+	ErrVersionMismatch = &Error{
+		Kind:    KindPreconditionFailed,
+		Code:    "version_mismatch",
+		Message: "o registro foi alterado por outra requisição",
+	}
+	// ErrVersionRequired indica uma escrita sem a versão esperada do registro
+	ErrVersionRequired = &Error{
+		Kind:    KindPreconditionRequired,
+		Code:    "version_required",
+		Message: "informe a versão do registro obtida na leitura",
+	}
+	// ErrInsufficientStock indica que a movimentação deixaria o estoque negativo
+	ErrInsufficientStock = NewConflictError("insufficient_stock", "estoque insuficiente")
+
+	errInvalidSKU      = invalidField("invalid_sku", "sku", "SKU deve ter até 64 caracteres entre letras, números, '.', '_' e '-'")
+	errInvalidPrice    = invalidField("invalid_price", "price", "preço deve ser maior que zero")
+	errPriceLimit      = invalidField("invalid_price", "price", "preço deve ser menor que 100000000")
+	errUnknownCategory = invalidField("unknown_category", "category_id", "categoria não encontrada")
+	errUnknownParent   = invalidField("unknown_parent_category", "parent_id", "categoria pai não encontrada")
+)
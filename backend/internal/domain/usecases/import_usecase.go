@@ -0,0 +1,293 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// MaxImportRows limita a quantidade de linhas de uma importação
+const MaxImportRows = 10000
+
+// errDryRun desfaz a transação de uma importação de simulação
+var errDryRun = errors.New("simulação")
+
+// ImportRow representa uma linha da planilha de produtos
+type ImportRow struct {
+	Line        int
+	SKU         string
+	Slug        string
+	Name        string
+	Description string
+	Image       string
+	// Price aceita ponto ou vírgula como separador decimal (ex: 29.90 ou 29,90)
+	Price    string
+	Currency string
+	Category string
+}
+
+// ImportOptions define o comportamento de uma importação
+type ImportOptions struct {
+	// DryRun valida e mostra o resultado sem gravar nada
+	DryRun bool
+	// CreateCategories cria as categorias que não existirem
+	CreateCategories bool
+}
+
+// ImportStatus representa o resultado de uma linha importada
+type ImportStatus string
+
+// Resultados possíveis de uma linha
+const (
+	ImportCreated ImportStatus = "created"
+	ImportUpdated ImportStatus = "updated"
+	ImportInvalid ImportStatus = "invalid"
+)
+
+// ImportRowResult representa o resultado da importação de uma linha
+type ImportRowResult struct {
+	Line      int
+	Status    ImportStatus
+	ProductID uint
+	SKU       string
+	Name      string
+	Changed   []string
+	Err       error
+}
+
+// ImportReport representa o relatório de uma importação
+type ImportReport struct {
+	DryRun            bool
+	Created           int
+	Updated           int
+	Invalid           int
+	CategoriesCreated []string
+	Rows              []ImportRowResult
+}
+
+// ImportUseCase define os casos de uso de importação de produtos
+type ImportUseCase interface {
+	ImportProducts(ctx context.Context, rows []ImportRow, options ImportOptions) (*ImportReport, error)
+}
+
+// importUseCase implementa ImportUseCase
+type importUseCase struct {
+	transactor repositories.Transactor
+	authorizer Authorizer
+}
+
+// NewImportUseCase cria uma nova instância de ImportUseCase
+func NewImportUseCase(transactor repositories.Transactor, authorizer Authorizer) ImportUseCase {
+	return &importUseCase{
+		transactor: transactor,
+		authorizer: authorizer,
+	}
+}
+
+// ImportProducts cria ou atualiza os produtos da planilha, localizando-os pelo SKU ou pelo
+// nome. Cada linha é gravada em um savepoint próprio: linhas inválidas não afetam as demais.
+// Em simulações, a transação inteira é desfeita ao final.
+func (uc *importUseCase) ImportProducts(ctx context.Context, rows []ImportRow, options ImportOptions) (*ImportReport, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionProductsImport); err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, NewValidationError("empty_import", "a planilha não possui linhas de produtos")
+	}
+	if len(rows) > MaxImportRows {
+		return nil, NewValidationError("import_too_large", fmt.Sprintf("a planilha excede o limite de %d linhas", MaxImportRows))
+	}
+
+	report := &ImportReport{DryRun: options.DryRun, CategoriesCreated: []string{}}
+
+	err := uc.transactor.WithinTransaction(func(repos repositories.Repositories) error {
+		importer := &productImporter{
+			authorizer: uc.authorizer,
+			repos:      repos,
+			options:    options,
+			categories: make(map[string]uint),
+			report:     report,
+		}
+		for _, row := range rows {
+			result := importer.importRow(ctx, row)
+			switch result.Status {
+			case ImportCreated:
+				report.Created++
+			case ImportUpdated:
+				report.Updated++
+			default:
+				report.Invalid++
+			}
+			report.Rows = append(report.Rows, result)
+		}
+
+		if options.DryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// productImporter importa as linhas de uma planilha dentro de uma transação
+type productImporter struct {
+	// authorizer autoriza a criação e a alteração de cada produto e a criação de cada categoria,
+	// como fora da importação
+	authorizer Authorizer
+	repos      repositories.Repositories
+	options    ImportOptions
+	// categories guarda os IDs já resolvidos pelo nome normalizado
+	categories map[string]uint
+	report     *ImportReport
+}
+
+// importRow importa uma linha em um savepoint próprio
+func (im *productImporter) importRow(ctx context.Context, row ImportRow) ImportRowResult {
+	result := ImportRowResult{Line: row.Line, SKU: strings.TrimSpace(row.SKU), Name: strings.TrimSpace(row.Name)}
+	var createdCategory string
+
+	err := im.repos.Transactor.WithinTransaction(func(repos repositories.Repositories) error {
+		products := &productUseCase{
+			productRepo:   repos.Products,
+			categoryRepo:  repos.Categories,
+			attributeRepo: repos.Attributes,
+			authorizer:    im.authorizer,
+		}
+
+		categories := &categoryUseCase{
+			categoryRepo:  repos.Categories,
+			attributeRepo: repos.Attributes,
+			authorizer:    im.authorizer,
+		}
+
+		categoryID, created, err := im.resolveCategory(ctx, categories, row.Category)
+		if err != nil {
+			return err
+		}
+		if created {
+			createdCategory = strings.TrimSpace(row.Category)
+		}
+
+		price, err := entities.ParseMoney(normalizeDecimal(row.Price), strings.TrimSpace(row.Currency))
+		if err != nil {
+			return err
+		}
+
+		input := ProductInput{
+			Name:        result.Name,
+			Image:       strings.TrimSpace(row.Image),
+			Description: row.Description,
+			Price:       price,
+			CategoryID:  categoryID,
+			SKU:         result.SKU,
+			Slug:        strings.TrimSpace(row.Slug),
+		}
+
+		existing := im.findExisting(repos.Products, input.SKU, input.Name)
+		if existing == nil {
+			product, err := products.CreateProduct(ctx, input)
+			if err != nil {
+				return err
+			}
+			result.Status, result.ProductID = ImportCreated, product.ID
+			return nil
+		}
+
+		if err := im.authorizer.Authorize(ctx, entities.PermissionProductsUpdate); err != nil {
+			return err
+		}
+
+		// Manter SKU, slug e atributos atuais quando a planilha não os informar
+		if input.SKU == "" {
+			input.SKU = existing.SKU
+		}
+		if input.Slug == "" {
+			input.Slug = existing.Slug
+		}
+		input.Attributes = existing.Attributes
+
+		changed, err := products.save(existing, input)
+		if err != nil {
+			return err
+		}
+		result.Status, result.ProductID, result.Changed = ImportUpdated, existing.ID, changed
+		return nil
+	})
+
+	if err != nil {
+		// O savepoint desfez a categoria criada por esta linha
+		if createdCategory != "" {
+			delete(im.categories, strings.ToLower(createdCategory))
+		}
+		result.Status, result.ProductID, result.Changed, result.Err = ImportInvalid, 0, nil, err
+		return result
+	}
+
+	if createdCategory != "" {
+		im.report.CategoriesCreated = append(im.report.CategoriesCreated, createdCategory)
+	}
+	return result
+}
+
+// resolveCategory localiza a categoria pelo nome, criando-a se a importação e o usuário permitirem
+func (im *productImporter) resolveCategory(ctx context.Context, categories *categoryUseCase, name string) (uint, bool, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return 0, false, errors.New("categoria é obrigatória")
+	}
+
+	key := strings.ToLower(name)
+	if id, ok := im.categories[key]; ok {
+		return id, false, nil
+	}
+
+	if category, err := categories.categoryRepo.GetByName(name); err == nil {
+		im.categories[key] = category.ID
+		return category.ID, false, nil
+	}
+
+	if !im.options.CreateCategories {
+		return 0, false, fmt.Errorf("categoria '%s' não encontrada", name)
+	}
+
+	category, err := categories.CreateCategory(ctx, name, nil)
+	if err != nil {
+		return 0, false, fmt.Errorf("não foi possível criar a categoria '%s': %w", name, err)
+	}
+	im.categories[key] = category.ID
+	return category.ID, true, nil
+}
+
+// findExisting localiza o produto pelo SKU. Somente linhas sem SKU recorrem ao nome, como no
+// seed: um SKU desconhecido é um produto novo, e não o SKU novo de um produto de mesmo nome.
+func (im *productImporter) findExisting(productRepo repositories.ProductRepository, sku, name string) *entities.Product {
+	if sku != "" {
+		if product, err := productRepo.GetBySKU(sku); err == nil {
+			return product
+		}
+		return nil
+	}
+	if name != "" {
+		if product, err := productRepo.GetByName(name); err == nil {
+			return product
+		}
+	}
+	return nil
+}
+
+// normalizeDecimal aceita vírgula como separador decimal quando não há ponto (ex: "29,90")
+func normalizeDecimal(value string) string {
+	value = strings.TrimSpace(value)
+	if !strings.Contains(value, ".") && strings.Count(value, ",") == 1 {
+		return strings.Replace(value, ",", ".", 1)
+	}
+	return value
+}
@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"context"
+)
+
+// BulkAction define o tipo de uma operação em lote
+type BulkAction string
+
+// Ações aceitas em operações em lote
+const (
+	BulkCreate BulkAction = "create"
+	BulkUpdate BulkAction = "update"
+	BulkDelete BulkAction = "delete"
+)
+
+// MaxBulkOperations limita a quantidade de operações em um único lote
+const MaxBulkOperations = 500
+
+// ErrBatchAborted indica que a operação não foi aplicada porque outra operação do lote atômico falhou
+var ErrBatchAborted = &Error{
+	Kind:    KindAborted,
+	Code:    "batch_aborted",
+	Message: "operação não aplicada: o lote foi desfeito por uma falha em outra operação",
+}
+
+// BulkOperation representa uma operação de um lote de produtos.
+// Create usa Input; update aplica Patch ao produto ID; delete usa apenas ID.
+type BulkOperation struct {
+	Action BulkAction
+	ID     uint
+	Input  ProductInput
+	Patch  ProductPatch
+	// Version é a versão esperada do produto em update e delete; obrigatória, AnyVersion aceita qualquer versão
+	Version int64
+	// Err registra uma entrada inválida detectada antes da execução; a operação falha com ele
+	Err error
+}
+
+// BulkResult representa o resultado de uma operação do lote
+type BulkResult struct {
+	Index   int
+	Action  BulkAction
+	ID      uint
+	Product *entities.Product
+	Changed []string
+	Err     error
+}
+
+// ExecuteBulk executa as operações em ordem. Sem atomic, cada operação é independente;
+// com atomic, o lote roda em uma única transação e é desfeito na primeira falha.
+func (uc *productUseCase) ExecuteBulk(ctx context.Context, operations []BulkOperation, atomic bool) ([]BulkResult, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionProductsBulk); err != nil {
+		return nil, err
+	}
+
+	if len(operations) == 0 {
+		return nil, invalidField("empty_batch", "operations", "nenhuma operação informada")
+	}
+	if len(operations) > MaxBulkOperations {
+		return nil, invalidField("batch_too_large", "operations", "o lote excede o limite de operações")
+	}
+
+	results := make([]BulkResult, len(operations))
+	for i, operation := range operations {
+		results[i] = BulkResult{Index: i, Action: operation.Action, ID: operation.ID}
+	}
+
+	if !atomic {
+		for i, operation := range operations {
+			uc.executeOperation(ctx, operation, &results[i])
+		}
+		return results, nil
+	}
+
+	// Entradas inválidas impedem o lote atômico antes de abrir a transação
+	for i, operation := range operations {
+		if operation.Err != nil {
+			for j := range results {
+				results[j].Err = ErrBatchAborted
+			}
+			results[i].Err = operation.Err
+			return results, nil
+		}
+	}
+
+	failed := -1
+	err := uc.transactor.WithinTransaction(func(repos repositories.Repositories) error {
+		txUseCase := &productUseCase{
+			productRepo:   repos.Products,
+			categoryRepo:  repos.Categories,
+			attributeRepo: repos.Attributes,
+			authorizer:    uc.authorizer,
+		}
+		for i, operation := range operations {
+			txUseCase.executeOperation(ctx, operation, &results[i])
+			if results[i].Err != nil {
+				failed = i
+				return results[i].Err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		// Nada foi gravado: marcar as demais operações como não aplicadas
+		for i := range results {
+			if i != failed {
+				results[i].Product = nil
+				results[i].Changed = nil
+				results[i].Err = ErrBatchAborted
+			}
+		}
+		if failed < 0 {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// executeOperation executa uma operação do lote e registra o resultado. Cada operação
+// também exige a permissão correspondente (ex: products:delete).
+func (uc *productUseCase) executeOperation(ctx context.Context, operation BulkOperation, result *BulkResult) {
+	if operation.Err != nil {
+		result.Err = operation.Err
+		return
+	}
+
+	switch operation.Action {
+	case BulkCreate:
+		product, err := uc.CreateProduct(ctx, operation.Input)
+		if err == nil {
+			result.ID = product.ID
+		}
+		result.Product, result.Err = product, err
+	case BulkUpdate:
+		patch := operation.Patch
+		patch.Version = operation.Version
+		result.Product, result.Changed, result.Err = uc.PatchProduct(ctx, operation.ID, patch)
+	case BulkDelete:
+		result.Err = uc.DeleteProduct(ctx, operation.ID, operation.Version)
+	default:
+		result.Err = invalidField("invalid_action", "action", "ação inválida: use create, update ou delete")
+	}
+}
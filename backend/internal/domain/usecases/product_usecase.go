@@ -3,61 +3,119 @@ package usecases
 import (
 	"catalogo-produtos/backend/internal/domain/entities"
 	"catalogo-produtos/backend/internal/domain/repositories"
+	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 )
 
+// ProductInput representa os dados para criar ou atualizar um produto
+type ProductInput struct {
+	Name        string
+	Image       string
+	Description string
+	Price       entities.Money
+	CategoryID  uint
+	SKU         string
+	// Slug é opcional; quando vazio é gerado a partir do nome
+	Slug string
+	// Attributes são validados contra o schema da categoria
+	Attributes map[string]interface{}
+	// Version é a versão esperada do produto em atualizações; obrigatória, AnyVersion aceita qualquer versão
+	Version int64
+}
+
+// ProductPatch representa uma atualização parcial de um produto (JSON Merge Patch).
+// Campos nil não são alterados; o SKU é obrigatório, de modo que SKU vazio é rejeitado, e slug
+// vazio o regenera a partir do nome.
+type ProductPatch struct {
+	Name        *string
+	Image       *string
+	Description *string
+	// Price é o valor decimal; com Currency, é interpretado na moeda atual do produto se omitida
+	Price      *string
+	Currency   *string
+	CategoryID *uint
+	SKU        *string
+	Slug       *string
+	// Attributes é mesclado aos atributos atuais; valores nil removem o atributo
+	Attributes map[string]interface{}
+	// ClearAttributes remove todos os atributos atuais antes da mesclagem
+	ClearAttributes bool
+	// Version é a versão esperada do produto; obrigatória, AnyVersion aceita qualquer versão
+	Version int64
+}
+
+// skuPattern define os caracteres aceitos em um SKU
+var skuPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
 // ProductUseCase define os casos de uso para produtos
 type ProductUseCase interface {
-	CreateProduct(name, image, description string, price float64, categoryID uint) (*entities.Product, error)
+	CreateProduct(ctx context.Context, input ProductInput) (*entities.Product, error)
 	GetProduct(id uint) (*entities.Product, error)
-	GetProducts(filters *repositories.ProductFilter) ([]entities.Product, error)
-	UpdateProduct(id uint, name, image, description string, price float64, categoryID uint) (*entities.Product, error)
-	DeleteProduct(id uint) error
+	GetProductBySKU(sku string) (*entities.Product, error)
+	GetProductBySlug(slug string) (*entities.Product, error)
+	GetProducts(filters *repositories.ProductFilter) ([]entities.Product, int64, error)
+	// GetProductsByCategories busca até limit produtos de cada categoria, agrupados pelo ID da categoria
+	GetProductsByCategories(categoryIDs []uint, limit int) (map[uint][]entities.Product, error)
+	ExportProducts(filters *repositories.ProductFilter, fn func(product *entities.Product) error) error
+	UpdateProduct(ctx context.Context, id uint, input ProductInput) (*entities.Product, error)
+	PatchProduct(ctx context.Context, id uint, patch ProductPatch) (*entities.Product, []string, error)
+	// DeleteProduct remove o produto se ele ainda estiver na versão informada (AnyVersion aceita qualquer versão)
+	DeleteProduct(ctx context.Context, id uint, version int64) error
+	ExecuteBulk(ctx context.Context, operations []BulkOperation, atomic bool) ([]BulkResult, error)
 }
 
 // productUseCase implementa ProductUseCase
 type productUseCase struct {
-	productRepo  repositories.ProductRepository
-	categoryRepo repositories.CategoryRepository
+	productRepo   repositories.ProductRepository
+	categoryRepo  repositories.CategoryRepository
+	attributeRepo repositories.AttributeRepository
+	transactor    repositories.Transactor
+	authorizer    Authorizer
 }
 
 // NewProductUseCase cria uma nova instância de ProductUseCase
-func NewProductUseCase(productRepo repositories.ProductRepository, categoryRepo repositories.CategoryRepository) ProductUseCase {
+func NewProductUseCase(productRepo repositories.ProductRepository, categoryRepo repositories.CategoryRepository, attributeRepo repositories.AttributeRepository, transactor repositories.Transactor, authorizer Authorizer) ProductUseCase {
 	return &productUseCase{
-		productRepo:  productRepo,
-		categoryRepo: categoryRepo,
+		productRepo:   productRepo,
+		categoryRepo:  categoryRepo,
+		attributeRepo: attributeRepo,
+		transactor:    transactor,
+		authorizer:    authorizer,
 	}
 }
 
 // CreateProduct cria um novo produto
-func (uc *productUseCase) CreateProduct(name, image, description string, price float64, categoryID uint) (*entities.Product, error) {
-	// Validar se a categoria existe
-	_, err := uc.categoryRepo.GetByID(categoryID)
-	if err != nil {
-		return nil, errors.New("categoria não encontrada")
+func (uc *productUseCase) CreateProduct(ctx context.Context, input ProductInput) (*entities.Product, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionProductsCreate); err != nil {
+		return nil, err
 	}
 
-	// Validar preço
-	if price <= 0 {
-		return nil, errors.New("preço deve ser maior que zero")
+	if err := uc.validateInput(&input, 0); err != nil {
+		return nil, err
 	}
 
-	// Validar nome
-	if name == "" {
-		return nil, errors.New("nome é obrigatório")
+	product := &entities.Product{
+		SKU:         input.SKU,
+		Slug:        input.Slug,
+		Name:        input.Name,
+		Image:       input.Image,
+		Price:       input.Price,
+		CategoryID:  input.CategoryID,
+		Description: input.Description,
+		Attributes:  input.Attributes,
 	}
 
-	product := &entities.Product{
-		Name:        name,
-		Image:       image,
-		Price:       price,
-		CategoryID:  categoryID,
-		Description: description,
+	if err := uc.checkUniqueness(product); err != nil {
+		return nil, err
 	}
 
-	err = uc.productRepo.Create(product)
+	err := uc.productRepo.Create(product)
 	if err != nil {
-		return nil, err
+		return nil, uc.translateWriteError(product, err)
 	}
 
 	return product, nil
@@ -67,66 +125,450 @@ func (uc *productUseCase) CreateProduct(name, image, description string, price f
 func (uc *productUseCase) GetProduct(id uint) (*entities.Product, error) {
 	product, err := uc.productRepo.GetByID(id)
 	if err != nil {
-		return nil, errors.New("produto não encontrado")
+		return nil, ErrProductNotFound
+	}
+	return product, nil
+}
+
+// GetProductBySKU busca um produto pelo SKU
+func (uc *productUseCase) GetProductBySKU(sku string) (*entities.Product, error) {
+	product, err := uc.productRepo.GetBySKU(sku)
+	if err != nil {
+		return nil, ErrProductNotFound
 	}
 	return product, nil
 }
 
-// GetProducts busca produtos com filtros
-func (uc *productUseCase) GetProducts(filters *repositories.ProductFilter) ([]entities.Product, error) {
-	products, err := uc.productRepo.GetAll(filters)
+// GetProductBySlug busca um produto pelo slug
+func (uc *productUseCase) GetProductBySlug(slug string) (*entities.Product, error) {
+	product, err := uc.productRepo.GetBySlug(slug)
+	if err != nil {
+		return nil, ErrProductNotFound
+	}
+	return product, nil
+}
+
+// GetProducts busca produtos com filtros, ordenação e paginação
+func (uc *productUseCase) GetProducts(filters *repositories.ProductFilter) ([]entities.Product, int64, error) {
+	if filters == nil {
+		filters = &repositories.ProductFilter{}
+	}
+
+	if err := validatePriceFilters(filters); err != nil {
+		return nil, 0, err
+	}
+
+	// Normalizar paginação
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.PageSize < 1 {
+		filters.PageSize = repositories.DefaultPageSize
+	}
+	if filters.PageSize > repositories.MaxPageSize {
+		filters.PageSize = repositories.MaxPageSize
+	}
+
+	// Expandir as categorias para incluir as subcategorias
+	if filters.IncludeSubcategories && len(filters.CategoryIDs) > 0 {
+		categoryIDs, err := uc.expandCategoryIDs(filters.CategoryIDs)
+		if err != nil {
+			return nil, 0, err
+		}
+		filters.CategoryIDs = categoryIDs
+	}
+
+	products, total, err := uc.productRepo.GetAll(filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return products, total, nil
+}
+
+// GetProductsByCategories busca até limit produtos de cada categoria com uma única consulta,
+// permitindo carregar os produtos de várias categorias sem uma consulta por categoria
+func (uc *productUseCase) GetProductsByCategories(categoryIDs []uint, limit int) (map[uint][]entities.Product, error) {
+	if limit < 1 {
+		limit = repositories.DefaultPageSize
+	}
+	if limit > repositories.MaxPageSize {
+		limit = repositories.MaxPageSize
+	}
+
+	products, err := uc.productRepo.GetByCategoryIDs(categoryIDs, limit)
 	if err != nil {
 		return nil, err
 	}
-	return products, nil
+
+	byCategory := make(map[uint][]entities.Product, len(categoryIDs))
+	for _, product := range products {
+		byCategory[product.CategoryID] = append(byCategory[product.CategoryID], product)
+	}
+	return byCategory, nil
+}
+
+// ExportProducts percorre todos os produtos filtrados, sem paginação, chamando fn para cada um
+func (uc *productUseCase) ExportProducts(filters *repositories.ProductFilter, fn func(product *entities.Product) error) error {
+	if filters == nil {
+		filters = &repositories.ProductFilter{}
+	}
+	if err := validatePriceFilters(filters); err != nil {
+		return err
+	}
+
+	if filters.IncludeSubcategories && len(filters.CategoryIDs) > 0 {
+		categoryIDs, err := uc.expandCategoryIDs(filters.CategoryIDs)
+		if err != nil {
+			return err
+		}
+		filters.CategoryIDs = categoryIDs
+	}
+
+	return uc.productRepo.Stream(filters, fn)
 }
 
 // UpdateProduct atualiza um produto
-func (uc *productUseCase) UpdateProduct(id uint, name, image, description string, price float64, categoryID uint) (*entities.Product, error) {
+func (uc *productUseCase) UpdateProduct(ctx context.Context, id uint, input ProductInput) (*entities.Product, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionProductsUpdate); err != nil {
+		return nil, err
+	}
+
 	// Buscar produto existente
 	product, err := uc.productRepo.GetByID(id)
 	if err != nil {
-		return nil, errors.New("produto não encontrado")
+		return nil, ErrProductNotFound
+	}
+	if err := checkVersion(input.Version, product.Version); err != nil {
+		return nil, err
 	}
 
+	// Manter SKU e slug atuais quando não informados, preservando URLs já publicadas
+	if strings.TrimSpace(input.SKU) == "" {
+		input.SKU = product.SKU
+	}
+	if strings.TrimSpace(input.Slug) == "" && product.Slug != "" {
+		input.Slug = product.Slug
+	}
+	if input.Attributes == nil {
+		input.Attributes = product.Attributes
+	}
+
+	if _, err := uc.save(product, input); err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// PatchProduct aplica uma atualização parcial e retorna os campos efetivamente alterados
+func (uc *productUseCase) PatchProduct(ctx context.Context, id uint, patch ProductPatch) (*entities.Product, []string, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionProductsUpdate); err != nil {
+		return nil, nil, err
+	}
+
+	product, err := uc.productRepo.GetByID(id)
+	if err != nil {
+		return nil, nil, ErrProductNotFound
+	}
+	if err := checkVersion(patch.Version, product.Version); err != nil {
+		return nil, nil, err
+	}
+
+	// Partir dos valores atuais e sobrepor apenas os campos enviados
+	input := ProductInput{
+		Name:        product.Name,
+		Image:       product.Image,
+		Description: product.Description,
+		Price:       product.Price,
+		CategoryID:  product.CategoryID,
+		SKU:         product.SKU,
+		Slug:        product.Slug,
+		Attributes:  make(map[string]interface{}, len(product.Attributes)),
+	}
+	for key, value := range product.Attributes {
+		input.Attributes[key] = value
+	}
+
+	if patch.Name != nil {
+		input.Name = *patch.Name
+	}
+	if patch.Image != nil {
+		input.Image = *patch.Image
+	}
+	if patch.Description != nil {
+		input.Description = *patch.Description
+	}
+	if patch.Price != nil || patch.Currency != nil {
+		amount, currency := product.Price.String(), product.Price.Currency
+		if patch.Price != nil {
+			amount = *patch.Price
+		}
+		if patch.Currency != nil {
+			currency = *patch.Currency
+		}
+		price, err := entities.ParseMoney(amount, currency)
+		if err != nil {
+			return nil, nil, invalidField("invalid_price", "price", err.Error())
+		}
+		input.Price = price
+	}
+	if patch.CategoryID != nil {
+		input.CategoryID = *patch.CategoryID
+	}
+	if patch.SKU != nil {
+		input.SKU = *patch.SKU
+	}
+	if patch.Slug != nil {
+		input.Slug = *patch.Slug
+	}
+	if patch.ClearAttributes {
+		input.Attributes = map[string]interface{}{}
+	}
+	for key, value := range patch.Attributes {
+		if value == nil {
+			delete(input.Attributes, key)
+			continue
+		}
+		input.Attributes[key] = value
+	}
+
+	changed, err := uc.save(product, input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return product, changed, nil
+}
+
+// DeleteProduct remove um produto
+func (uc *productUseCase) DeleteProduct(ctx context.Context, id uint, version int64) error {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionProductsDelete); err != nil {
+		return err
+	}
+
+	// Verificar se o produto existe
+	product, err := uc.productRepo.GetByID(id)
+	if err != nil {
+		return ErrProductNotFound
+	}
+	if err := checkVersion(version, product.Version); err != nil {
+		return err
+	}
+
+	// A remoção confere a versão lida, e falha se o produto mudou desde a verificação
+	return versionConflict(uc.productRepo.Delete(id, product.Version))
+}
+
+// save valida a entrada, aplica ao produto e persiste somente os campos alterados
+func (uc *productUseCase) save(product *entities.Product, input ProductInput) ([]string, error) {
+	if err := uc.validateInput(&input, product.ID); err != nil {
+		return nil, err
+	}
+
+	changed := []string{}
+	if product.SKU != input.SKU {
+		product.SKU = input.SKU
+		changed = append(changed, "sku")
+	}
+	if product.Slug != input.Slug {
+		product.Slug = input.Slug
+		changed = append(changed, "slug")
+	}
+	if product.Name != input.Name {
+		product.Name = input.Name
+		changed = append(changed, "name")
+	}
+	if product.Image != input.Image {
+		product.Image = input.Image
+		changed = append(changed, "image")
+	}
+	if product.Price != input.Price {
+		if product.Price.Currency != input.Price.Currency {
+			changed = append(changed, "currency")
+		}
+		if product.Price.String() != input.Price.String() {
+			changed = append(changed, "price")
+		}
+		product.Price = input.Price
+	}
+	if product.CategoryID != input.CategoryID {
+		product.CategoryID = input.CategoryID
+		if category, err := uc.categoryRepo.GetByID(input.CategoryID); err == nil {
+			product.Category = *category
+		}
+		changed = append(changed, "category_id")
+	}
+	if product.Description != input.Description {
+		product.Description = input.Description
+		changed = append(changed, "description")
+	}
+	if !reflect.DeepEqual(product.Attributes, input.Attributes) {
+		product.Attributes = input.Attributes
+		changed = append(changed, "attributes")
+	}
+
+	if len(changed) == 0 {
+		return changed, nil
+	}
+
+	if err := uc.checkUniqueness(product); err != nil {
+		return nil, err
+	}
+
+	if err := uc.productRepo.Update(product, changed...); err != nil {
+		if errors.Is(err, repositories.ErrVersionConflict) {
+			return nil, ErrVersionMismatch
+		}
+		return nil, uc.translateWriteError(product, err)
+	}
+
+	return changed, nil
+}
+
+// expandCategoryIDs retorna os IDs informados e de todas as suas subcategorias
+func (uc *productUseCase) expandCategoryIDs(categoryIDs []uint) ([]uint, error) {
+	seen := make(map[uint]bool)
+	var expanded []uint
+	for _, categoryID := range categoryIDs {
+		descendantIDs, err := uc.categoryRepo.GetDescendantIDs(categoryID)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range descendantIDs {
+			if !seen[id] {
+				seen[id] = true
+				expanded = append(expanded, id)
+			}
+		}
+	}
+
+	// Sem categorias encontradas, manter os IDs originais para que a busca continue vazia
+	if len(expanded) == 0 {
+		return categoryIDs, nil
+	}
+	return expanded, nil
+}
+
+// validateInput valida e normaliza os dados de entrada do produto id (zero para um produto novo)
+func (uc *productUseCase) validateInput(input *ProductInput, id uint) error {
 	// Validar se a categoria existe
-	_, err = uc.categoryRepo.GetByID(categoryID)
+	_, err := uc.categoryRepo.GetByID(input.CategoryID)
 	if err != nil {
-		return nil, errors.New("categoria não encontrada")
+		return errUnknownCategory
 	}
 
 	// Validar preço
-	if price <= 0 {
-		return nil, errors.New("preço deve ser maior que zero")
+	if !input.Price.IsPositive() {
+		return errInvalidPrice
+	}
+	if !input.Price.IsWithinLimit() {
+		return errPriceLimit
 	}
 
 	// Validar nome
-	if name == "" {
-		return nil, errors.New("nome é obrigatório")
+	if input.Name == "" {
+		return invalidField("name_required", "name", "nome é obrigatório")
 	}
 
-	// Atualizar campos
-	product.Name = name
-	product.Image = image
-	product.Price = price
-	product.CategoryID = categoryID
-	product.Description = description
+	// Validar SKU
+	input.SKU = strings.TrimSpace(input.SKU)
+	if input.SKU == "" {
+		return invalidField("sku_required", "sku", "SKU é obrigatório")
+	}
+	if !skuPattern.MatchString(input.SKU) {
+		return errInvalidSKU
+	}
 
-	err = uc.productRepo.Update(product)
+	// Gerar ou normalizar o slug; um slug gerado a partir do nome recebe um sufixo numérico
+	// se já estiver em uso, enquanto um slug informado em uso é um conflito
+	generated := strings.TrimSpace(input.Slug) == ""
+	if generated {
+		input.Slug = input.Name
+	}
+	input.Slug = entities.Slugify(input.Slug)
+	if input.Slug == "" {
+		return invalidField("invalid_slug", "slug", "não foi possível gerar um slug a partir do nome")
+	}
+	if generated {
+		input.Slug = uc.availableSlug(input.Slug, id)
+	}
+
+	// Validar atributos contra o schema efetivo da categoria
+	if input.Attributes == nil {
+		input.Attributes = map[string]interface{}{}
+	}
+	schema, err := resolveSchema(uc.categoryRepo, uc.attributeRepo, input.CategoryID)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if err := validateAttributes(schema, input.Attributes); err != nil {
+		return err
 	}
 
-	return product, nil
+	return nil
 }
 
-// DeleteProduct remove um produto
-func (uc *productUseCase) DeleteProduct(id uint) error {
-	// Verificar se o produto existe
-	_, err := uc.productRepo.GetByID(id)
-	if err != nil {
-		return errors.New("produto não encontrado")
+// checkUniqueness garante que SKU e slug não estão em uso por outro produto
+func (uc *productUseCase) checkUniqueness(product *entities.Product) error {
+	if product.SKU != "" {
+		if existing, err := uc.productRepo.GetBySKU(product.SKU); err == nil && existing.ID != product.ID {
+			return fieldInUse("sku", product.SKU)
+		}
+	}
+
+	if existing, err := uc.productRepo.GetBySlug(product.Slug); err == nil && existing.ID != product.ID {
+		return fieldInUse("slug", product.Slug)
 	}
 
-	return uc.productRepo.Delete(id)
+	return nil
+}
+
+// availableSlug retorna o slug, ou o primeiro com sufixo numérico (ex: tenis-nike-2) que não
+// esteja em uso por outro produto
+func (uc *productUseCase) availableSlug(slug string, id uint) string {
+	candidate := slug
+	for n := 2; ; n++ {
+		existing, err := uc.productRepo.GetBySlug(candidate)
+		if err != nil || existing.ID == id {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s-%d", slug, n)
+	}
+}
+
+// translateWriteError converte violações de unicidade concorrentes (inclusive com o SKU de
+// uma variante) em erros de conflito do campo violado, e a categoria removida durante a gravação
+// em categoria desconhecida
+func (uc *productUseCase) translateWriteError(product *entities.Product, err error) error {
+	if errors.Is(err, repositories.ErrCategoryNotFound) {
+		return errUnknownCategory
+	}
+	var duplicate *repositories.DuplicateKeyError
+	if !errors.As(err, &duplicate) {
+		return err
+	}
+	switch duplicate.Field {
+	case "sku":
+		return fieldInUse("sku", product.SKU)
+	case "slug":
+		return fieldInUse("slug", product.Slug)
+	default:
+		return err
+	}
+}
+
+// validatePriceFilters recusa filtros de preço em moedas diferentes entre si ou da moeda
+// filtrada, que não podem ser comparados
+func validatePriceFilters(filters *repositories.ProductFilter) error {
+	currency := filters.Currency
+	for _, price := range []*entities.Money{filters.MinPrice, filters.MaxPrice} {
+		if price == nil {
+			continue
+		}
+		if currency != "" && price.Currency != currency {
+			return invalidField("currency_mismatch", "currency", "os filtros de preço devem estar na mesma moeda")
+		}
+		currency = price.Currency
+	}
+	return nil
 }
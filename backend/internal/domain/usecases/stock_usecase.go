@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"context"
+	"errors"
+)
+
+// StockUseCase define os casos de uso para o estoque de produtos
+type StockUseCase interface {
+	RecordMovement(ctx context.Context, productID uint, variantID *uint, movementType entities.StockMovementType, quantity int64, note string) (*entities.StockMovement, error)
+	GetMovements(productID uint, page, pageSize int) ([]entities.StockMovement, int64, error)
+}
+
+// stockUseCase implementa StockUseCase
+type stockUseCase struct {
+	stockRepo   repositories.StockRepository
+	productRepo repositories.ProductRepository
+	variantRepo repositories.VariantRepository
+	authorizer  Authorizer
+}
+
+// NewStockUseCase cria uma nova instância de StockUseCase
+func NewStockUseCase(stockRepo repositories.StockRepository, productRepo repositories.ProductRepository, variantRepo repositories.VariantRepository, authorizer Authorizer) StockUseCase {
+	return &stockUseCase{
+		stockRepo:   stockRepo,
+		productRepo: productRepo,
+		variantRepo: variantRepo,
+		authorizer:  authorizer,
+	}
+}
+
+// RecordMovement registra uma movimentação de estoque do produto ou de uma de suas variantes.
+// Entradas (receipt, return) e saídas (sale) recebem quantidades positivas;
+// ajustes recebem a variação com sinal.
+func (uc *stockUseCase) RecordMovement(ctx context.Context, productID uint, variantID *uint, movementType entities.StockMovementType, quantity int64, note string) (*entities.StockMovement, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionStockWrite); err != nil {
+		return nil, err
+	}
+
+	// Verificar se o produto existe
+	if _, err := uc.productRepo.GetByID(productID); err != nil {
+		return nil, ErrProductNotFound
+	}
+
+	// Verificar se a variante pertence ao produto
+	if variantID != nil {
+		variant, err := uc.variantRepo.GetByID(*variantID)
+		if err != nil || variant.ProductID != productID {
+			return nil, ErrVariantNotFound
+		}
+	}
+
+	// Converter a quantidade informada na variação aplicada ao estoque
+	var delta int64
+	switch movementType {
+	case entities.StockMovementReceipt, entities.StockMovementReturn:
+		if quantity <= 0 {
+			return nil, invalidField("invalid_quantity", "quantity", "quantidade deve ser maior que zero")
+		}
+		delta = quantity
+	case entities.StockMovementSale:
+		if quantity <= 0 {
+			return nil, invalidField("invalid_quantity", "quantity", "quantidade deve ser maior que zero")
+		}
+		delta = -quantity
+	case entities.StockMovementAdjustment:
+		if quantity == 0 {
+			return nil, invalidField("invalid_quantity", "quantity", "quantidade do ajuste não pode ser zero")
+		}
+		delta = quantity
+	default:
+		return nil, invalidField("invalid_movement_type", "type", "tipo de movimentação inválido")
+	}
+
+	movement := &entities.StockMovement{
+		ProductID: productID,
+		VariantID: variantID,
+		Type:      movementType,
+		Quantity:  delta,
+		Note:      note,
+	}
+
+	if err := uc.stockRepo.RecordMovement(movement); err != nil {
+		if errors.Is(err, repositories.ErrInsufficientStock) {
+			return nil, ErrInsufficientStock
+		}
+		return nil, err
+	}
+
+	return movement, nil
+}
+
+// GetMovements busca o histórico de movimentações de um produto
+func (uc *stockUseCase) GetMovements(productID uint, page, pageSize int) ([]entities.StockMovement, int64, error) {
+	// Verificar se o produto existe
+	if _, err := uc.productRepo.GetByID(productID); err != nil {
+		return nil, 0, ErrProductNotFound
+	}
+
+	// Normalizar paginação
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = repositories.DefaultPageSize
+	}
+	if pageSize > repositories.MaxPageSize {
+		pageSize = repositories.MaxPageSize
+	}
+
+	return uc.stockRepo.GetMovements(productID, page, pageSize)
+}
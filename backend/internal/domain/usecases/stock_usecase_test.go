@@ -0,0 +1,227 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"context"
+	"errors"
+	"testing"
+)
+
+// ledgerStockRepository é um livro-razão em memória com a mesma regra do banco: o saldo
+// nunca fica negativo. Produtos e variantes têm saldos separados, pois os IDs podem coincidir.
+type ledgerStockRepository struct {
+	productBalances map[uint]int64
+	variantBalances map[uint]int64
+	movements       []entities.StockMovement
+}
+
+func newLedgerStockRepository() *ledgerStockRepository {
+	return &ledgerStockRepository{productBalances: map[uint]int64{}, variantBalances: map[uint]int64{}}
+}
+
+func (r *ledgerStockRepository) RecordMovement(movement *entities.StockMovement) error {
+	balances, key := r.productBalances, movement.ProductID
+	if movement.VariantID != nil {
+		balances, key = r.variantBalances, *movement.VariantID
+	}
+	balance := balances[key] + movement.Quantity
+	if balance < 0 {
+		return repositories.ErrInsufficientStock
+	}
+	balances[key] = balance
+	movement.ID = uint(len(r.movements) + 1)
+	movement.BalanceAfter = balance
+	r.movements = append(r.movements, *movement)
+	return nil
+}
+
+func (r *ledgerStockRepository) GetMovements(productID uint, page, pageSize int) ([]entities.StockMovement, int64, error) {
+	return r.movements, int64(len(r.movements)), nil
+}
+
+// stubProductRepository implementa apenas a busca por ID
+type stubProductRepository struct {
+	repositories.ProductRepository
+	products map[uint]*entities.Product
+}
+
+func (r *stubProductRepository) GetByID(id uint) (*entities.Product, error) {
+	if product, ok := r.products[id]; ok {
+		return product, nil
+	}
+	return nil, errors.New("not found")
+}
+
+// stubVariantRepository implementa apenas a busca por ID
+type stubVariantRepository struct {
+	repositories.VariantRepository
+	variants map[uint]*entities.ProductVariant
+}
+
+func (r *stubVariantRepository) GetByID(id uint) (*entities.ProductVariant, error) {
+	if variant, ok := r.variants[id]; ok {
+		return variant, nil
+	}
+	return nil, errors.New("not found")
+}
+
+// contextWithRoles simula uma requisição autenticada com os papéis informados
+func contextWithRoles(roles ...entities.Role) context.Context {
+	return ContextWithClaims(context.Background(), &entities.TokenClaims{Subject: "user:1", Roles: roles})
+}
+
+func TestRecordMovement(t *testing.T) {
+	variantID := uint(10)
+	otherVariantID := uint(20)
+	// sameIDVariant tem o mesmo ID do produto 1
+	sameIDVariant := uint(1)
+
+	type movement struct {
+		productID uint
+		variantID *uint
+		kind      entities.StockMovementType
+		quantity  int64
+	}
+	tests := []struct {
+		name string
+		// before são lançados antes da movimentação testada
+		before      []movement
+		movement    movement
+		wantDelta   int64
+		wantBalance int64
+		wantCode    string
+	}{
+		{name: "entrada", movement: movement{productID: 1, kind: entities.StockMovementReceipt, quantity: 5}, wantDelta: 5, wantBalance: 5},
+		{name: "devolução", movement: movement{productID: 1, kind: entities.StockMovementReturn, quantity: 2}, wantDelta: 2, wantBalance: 2},
+		{
+			name:        "venda",
+			before:      []movement{{productID: 1, kind: entities.StockMovementReceipt, quantity: 5}},
+			movement:    movement{productID: 1, kind: entities.StockMovementSale, quantity: 3},
+			wantDelta:   -3,
+			wantBalance: 2,
+		},
+		{
+			name:        "ajuste negativo",
+			before:      []movement{{productID: 1, kind: entities.StockMovementReceipt, quantity: 5}},
+			movement:    movement{productID: 1, kind: entities.StockMovementAdjustment, quantity: -5},
+			wantDelta:   -5,
+			wantBalance: 0,
+		},
+		{
+			name:     "venda sem saldo",
+			before:   []movement{{productID: 1, kind: entities.StockMovementReceipt, quantity: 2}},
+			movement: movement{productID: 1, kind: entities.StockMovementSale, quantity: 3},
+			wantCode: "insufficient_stock",
+		},
+		{
+			name:     "ajuste abaixo de zero",
+			movement: movement{productID: 1, kind: entities.StockMovementAdjustment, quantity: -1},
+			wantCode: "insufficient_stock",
+		},
+		{
+			name:        "saldo de cada produto separado",
+			before:      []movement{{productID: 1, kind: entities.StockMovementReceipt, quantity: 10}},
+			movement:    movement{productID: 2, kind: entities.StockMovementReceipt, quantity: 4},
+			wantDelta:   4,
+			wantBalance: 4,
+		},
+		{
+			name:        "saldo da variante separado do produto",
+			before:      []movement{{productID: 1, kind: entities.StockMovementReceipt, quantity: 10}},
+			movement:    movement{productID: 1, variantID: &variantID, kind: entities.StockMovementReceipt, quantity: 4},
+			wantDelta:   4,
+			wantBalance: 4,
+		},
+		{
+			name:     "venda da variante sem saldo",
+			before:   []movement{{productID: 1, kind: entities.StockMovementReceipt, quantity: 10}},
+			movement: movement{productID: 1, variantID: &variantID, kind: entities.StockMovementSale, quantity: 1},
+			wantCode: "insufficient_stock",
+		},
+		{
+			name:     "variante com o mesmo ID do produto",
+			before:   []movement{{productID: 1, kind: entities.StockMovementReceipt, quantity: 10}},
+			movement: movement{productID: 1, variantID: &sameIDVariant, kind: entities.StockMovementSale, quantity: 1},
+			wantCode: "insufficient_stock",
+		},
+		{name: "quantidade zero", movement: movement{productID: 1, kind: entities.StockMovementReceipt, quantity: 0}, wantCode: "invalid_quantity"},
+		{name: "venda negativa", movement: movement{productID: 1, kind: entities.StockMovementSale, quantity: -1}, wantCode: "invalid_quantity"},
+		{name: "ajuste zero", movement: movement{productID: 1, kind: entities.StockMovementAdjustment, quantity: 0}, wantCode: "invalid_quantity"},
+		{name: "tipo inválido", movement: movement{productID: 1, kind: "transfer", quantity: 1}, wantCode: "invalid_movement_type"},
+		{name: "produto inexistente", movement: movement{productID: 3, kind: entities.StockMovementReceipt, quantity: 1}, wantCode: "product_not_found"},
+		{name: "variante de outro produto", movement: movement{productID: 1, variantID: &otherVariantID, kind: entities.StockMovementReceipt, quantity: 1}, wantCode: "variant_not_found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stockRepo := newLedgerStockRepository()
+			uc := NewStockUseCase(
+				stockRepo,
+				&stubProductRepository{products: map[uint]*entities.Product{1: {ID: 1}, 2: {ID: 2}}},
+				&stubVariantRepository{variants: map[uint]*entities.ProductVariant{
+					variantID:      {ID: variantID, ProductID: 1},
+					otherVariantID: {ID: otherVariantID, ProductID: 2},
+					sameIDVariant:  {ID: sameIDVariant, ProductID: 1},
+				}},
+				NewRoleAuthorizer(),
+			)
+			ctx := contextWithRoles(entities.RoleEditor)
+
+			for _, m := range tt.before {
+				if _, err := uc.RecordMovement(ctx, m.productID, m.variantID, m.kind, m.quantity, ""); err != nil {
+					t.Fatalf("movimentação inicial falhou: %v", err)
+				}
+			}
+			recorded := len(stockRepo.movements)
+
+			got, err := uc.RecordMovement(ctx, tt.movement.productID, tt.movement.variantID, tt.movement.kind, tt.movement.quantity, "nota")
+			if tt.wantCode != "" {
+				var ucErr *Error
+				if !errors.As(err, &ucErr) || ucErr.Code != tt.wantCode {
+					t.Fatalf("erro = %v, esperado %s", err, tt.wantCode)
+				}
+				if len(stockRepo.movements) != recorded {
+					t.Errorf("movimentação recusada foi registrada no livro-razão")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RecordMovement retornou erro: %v", err)
+			}
+			if got.Quantity != tt.wantDelta {
+				t.Errorf("Quantity = %d, esperado %d", got.Quantity, tt.wantDelta)
+			}
+			if got.BalanceAfter != tt.wantBalance {
+				t.Errorf("BalanceAfter = %d, esperado %d", got.BalanceAfter, tt.wantBalance)
+			}
+		})
+	}
+}
+
+func TestRecordMovementRequiresPermission(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode string
+	}{
+		{name: "sem autenticação", ctx: context.Background(), wantCode: "authentication_required"},
+		{name: "viewer", ctx: contextWithRoles(entities.RoleViewer), wantCode: "permission_denied"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stockRepo := newLedgerStockRepository()
+			uc := NewStockUseCase(stockRepo, &stubProductRepository{}, &stubVariantRepository{}, NewRoleAuthorizer())
+
+			_, err := uc.RecordMovement(tt.ctx, 1, nil, entities.StockMovementReceipt, 1, "")
+			var ucErr *Error
+			if !errors.As(err, &ucErr) || ucErr.Code != tt.wantCode {
+				t.Fatalf("erro = %v, esperado %s", err, tt.wantCode)
+			}
+			if len(stockRepo.movements) != 0 {
+				t.Error("movimentação sem permissão foi registrada")
+			}
+		})
+	}
+}
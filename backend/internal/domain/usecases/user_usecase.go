@@ -0,0 +1,412 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// PasswordHasher calcula e verifica hashes de senha
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	// Compare retorna erro se a senha não corresponder ao hash
+	Compare(hash, password string) error
+}
+
+// Limites de tamanho da senha. O máximo é o do bcrypt, que ignora o que passa de 72 bytes.
+const (
+	MinPasswordLength = 8
+	MaxPasswordLength = 72
+)
+
+// UserInput representa os dados de um novo usuário
+type UserInput struct {
+	Email    string
+	Name     string
+	Password string
+	// Roles são os papéis do usuário; vazio concede apenas viewer
+	Roles []entities.Role
+}
+
+// UserPatch representa uma atualização parcial de um usuário; campos nil são mantidos
+type UserPatch struct {
+	Name  *string
+	Roles *[]entities.Role
+}
+
+// Erros de usuários
+var (
+	// ErrUserNotFound indica que o usuário não existe
+	ErrUserNotFound = NewNotFoundError("user_not_found", "usuário não encontrado")
+
+	errInvalidCredentials     = NewUnauthenticatedError("invalid_credentials", "e-mail ou senha inválidos")
+	errAccountDisabled        = NewUnauthenticatedError("account_disabled", "conta desativada")
+	errInvalidEmail           = invalidField("invalid_email", "email", "e-mail inválido")
+	errUserNameRequired       = invalidField("name_required", "name", "nome é obrigatório")
+	errInvalidCurrentPassword = invalidField("invalid_current_password", "current_password", "senha atual incorreta")
+	errCannotDisableSelf      = NewConflictError("cannot_disable_self", "não é possível desativar a própria conta")
+	errCannotDemoteSelf       = NewConflictError("cannot_demote_self", "não é possível remover o próprio papel de admin")
+	errLastAdmin              = NewConflictError("last_admin", "o catálogo precisa de ao menos um admin ativo")
+	errNotAUser               = &Error{
+		Kind:    KindPermissionDenied,
+		Code:    "not_a_user",
+		Message: "o token de acesso não pertence a um usuário",
+	}
+)
+
+// UserUseCase define os casos de uso de contas de usuário
+type UserUseCase interface {
+	// Register cria uma conta com o papel viewer; outros papéis são concedidos por um administrador
+	Register(input UserInput) (*entities.User, error)
+	// Login verifica as credenciais e inicia uma sessão com os papéis do usuário
+	Login(email, password string) (*entities.TokenPair, error)
+	// ChangePassword troca a senha do usuário autenticado, encerra suas sessões e inicia uma nova
+	ChangePassword(ctx context.Context, currentPassword, newPassword string) (*entities.TokenPair, error)
+	CreateUser(ctx context.Context, input UserInput) (*entities.User, error)
+	GetUser(ctx context.Context, id uint) (*entities.User, error)
+	GetUsers(ctx context.Context) ([]entities.User, error)
+	// UpdateUser altera nome e papéis; alterar os papéis encerra as sessões do usuário. Ninguém
+	// remove o próprio papel de admin, nem o do último admin ativo.
+	UpdateUser(ctx context.Context, id uint, patch UserPatch) (*entities.User, error)
+	// SetPassword redefine a senha de um usuário e encerra suas sessões
+	SetPassword(ctx context.Context, id uint, password string) error
+	// DisableUser impede novos logins e encerra as sessões do usuário; não desativa a própria conta
+	// nem o último admin ativo
+	DisableUser(ctx context.Context, id uint) (*entities.User, error)
+	EnableUser(ctx context.Context, id uint) (*entities.User, error)
+}
+
+// userUseCase implementa UserUseCase
+type userUseCase struct {
+	userRepo    repositories.UserRepository
+	hasher      PasswordHasher
+	authUseCase AuthUseCase
+	authorizer  Authorizer
+}
+
+// NewUserUseCase cria uma nova instância de UserUseCase
+func NewUserUseCase(userRepo repositories.UserRepository, hasher PasswordHasher, authUseCase AuthUseCase, authorizer Authorizer) UserUseCase {
+	return &userUseCase{
+		userRepo:    userRepo,
+		hasher:      hasher,
+		authUseCase: authUseCase,
+		authorizer:  authorizer,
+	}
+}
+
+// Register cria uma conta de viewer, ignorando os papéis informados
+func (uc *userUseCase) Register(input UserInput) (*entities.User, error) {
+	input.Roles = nil
+	return uc.create(input)
+}
+
+// Login inicia uma sessão para o usuário
+func (uc *userUseCase) Login(email, password string) (*entities.TokenPair, error) {
+	user, err := uc.userRepo.GetByEmail(normalizeEmail(email))
+	if err != nil {
+		// Calcular um hash mesmo assim, para que o tempo de resposta não revele quais e-mails existem
+		uc.hasher.Hash(password)
+		return nil, errInvalidCredentials
+	}
+	if err := uc.hasher.Compare(user.PasswordHash, password); err != nil {
+		return nil, errInvalidCredentials
+	}
+	if user.Disabled {
+		return nil, errAccountDisabled
+	}
+
+	now := time.Now()
+	user.LastLoginAt = &now
+	if err := uc.userRepo.Update(user, "last_login_at"); err != nil {
+		return nil, err
+	}
+
+	return uc.authUseCase.IssueTokens(entities.UserSubject(user.ID), userClaims(user))
+}
+
+// ChangePassword troca a senha de quem está autenticado, mediante a senha atual
+func (uc *userUseCase) ChangePassword(ctx context.Context, currentPassword, newPassword string) (*entities.TokenPair, error) {
+	claims := ClaimsFromContext(ctx)
+	if claims == nil {
+		return nil, ErrAuthenticationRequired
+	}
+	id, ok := entities.ParseUserSubject(claims.Subject)
+	if !ok {
+		return nil, errNotAUser
+	}
+
+	user, err := uc.userRepo.GetByID(id)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	if user.Disabled {
+		return nil, errAccountDisabled
+	}
+	if err := uc.hasher.Compare(user.PasswordHash, currentPassword); err != nil {
+		return nil, errInvalidCurrentPassword
+	}
+
+	if err := uc.savePassword(user, "new_password", newPassword); err != nil {
+		return nil, err
+	}
+	return uc.authUseCase.IssueTokens(entities.UserSubject(user.ID), userClaims(user))
+}
+
+// CreateUser cria um usuário com os papéis informados
+func (uc *userUseCase) CreateUser(ctx context.Context, input UserInput) (*entities.User, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionUsersManage); err != nil {
+		return nil, err
+	}
+	return uc.create(input)
+}
+
+// GetUser busca um usuário por ID
+func (uc *userUseCase) GetUser(ctx context.Context, id uint) (*entities.User, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionUsersManage); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(id)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+	return user, nil
+}
+
+// GetUsers busca todos os usuários
+func (uc *userUseCase) GetUsers(ctx context.Context) ([]entities.User, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionUsersManage); err != nil {
+		return nil, err
+	}
+	return uc.userRepo.GetAll()
+}
+
+// UpdateUser aplica as alterações informadas
+func (uc *userUseCase) UpdateUser(ctx context.Context, id uint, patch UserPatch) (*entities.User, error) {
+	user, err := uc.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	if patch.Name != nil {
+		name := strings.TrimSpace(*patch.Name)
+		if name == "" {
+			return nil, errUserNameRequired
+		}
+		user.Name = name
+		fields = append(fields, "name")
+	}
+	if patch.Roles != nil {
+		roles, err := normalizeRoles(*patch.Roles)
+		if err != nil {
+			return nil, err
+		}
+		if isSelf(ctx, id) && user.IsActiveAdmin() && !entities.HasRole(roles, entities.RoleAdmin) {
+			return nil, errCannotDemoteSelf
+		}
+		user.Roles = roles
+		fields = append(fields, "roles")
+	}
+	if len(fields) == 0 {
+		return user, nil
+	}
+
+	if err := uc.userRepo.Update(user, fields...); err != nil {
+		return nil, lastAdmin(err)
+	}
+
+	// Os papéis vão nas claims dos refresh tokens: as sessões são encerradas para que valham os novos
+	if patch.Roles != nil {
+		if err := uc.authUseCase.RevokeSubject(entities.UserSubject(user.ID)); err != nil {
+			return nil, err
+		}
+	}
+	return user, nil
+}
+
+// SetPassword redefine a senha sem exigir a atual
+func (uc *userUseCase) SetPassword(ctx context.Context, id uint, password string) error {
+	user, err := uc.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	return uc.savePassword(user, "password", password)
+}
+
+// DisableUser desativa a conta
+func (uc *userUseCase) DisableUser(ctx context.Context, id uint) (*entities.User, error) {
+	user, err := uc.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if isSelf(ctx, id) {
+		return nil, errCannotDisableSelf
+	}
+	if user.Disabled {
+		return user, nil
+	}
+
+	user.Disabled = true
+	if err := uc.userRepo.Update(user, "disabled"); err != nil {
+		return nil, lastAdmin(err)
+	}
+	if err := uc.authUseCase.RevokeSubject(entities.UserSubject(user.ID)); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// EnableUser reativa a conta
+func (uc *userUseCase) EnableUser(ctx context.Context, id uint) (*entities.User, error) {
+	user, err := uc.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !user.Disabled {
+		return user, nil
+	}
+
+	user.Disabled = false
+	if err := uc.userRepo.Update(user, "disabled"); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// create valida os dados e cria o usuário com o hash da senha
+func (uc *userUseCase) create(input UserInput) (*entities.User, error) {
+	email := normalizeEmail(input.Email)
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, errUserNameRequired
+	}
+	if err := validatePassword("password", input.Password); err != nil {
+		return nil, err
+	}
+	roles, err := normalizeRoles(input.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := uc.hasher.Hash(input.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &entities.User{
+		Email:        email,
+		Name:         name,
+		PasswordHash: hash,
+		Roles:        roles,
+	}
+	if err := uc.userRepo.Create(user); err != nil {
+		if errors.Is(err, repositories.ErrDuplicateKey) {
+			return nil, fieldInUse("email", email)
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// savePassword valida e grava a nova senha, encerrando as sessões do usuário
+func (uc *userUseCase) savePassword(user *entities.User, field, password string) error {
+	if err := validatePassword(field, password); err != nil {
+		return err
+	}
+
+	hash, err := uc.hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+	user.PasswordHash = hash
+	if err := uc.userRepo.Update(user, "password"); err != nil {
+		return err
+	}
+	return uc.authUseCase.RevokeSubject(entities.UserSubject(user.ID))
+}
+
+// isSelf indica se o usuário autenticado é o usuário informado
+func isSelf(ctx context.Context, id uint) bool {
+	claims := ClaimsFromContext(ctx)
+	return claims != nil && claims.Subject == entities.UserSubject(id)
+}
+
+// lastAdmin converte a remoção do último admin ativo no erro correspondente
+func lastAdmin(err error) error {
+	if errors.Is(err, repositories.ErrLastAdmin) {
+		return errLastAdmin
+	}
+	return err
+}
+
+// userClaims monta as claims dos tokens emitidos para o usuário
+func userClaims(user *entities.User) map[string]interface{} {
+	roles := make([]string, len(user.Roles))
+	for i, role := range user.Roles {
+		roles[i] = string(role)
+	}
+	return map[string]interface{}{
+		entities.RolesClaim: roles,
+		"email":             user.Email,
+		"name":              user.Name,
+	}
+}
+
+// normalizeEmail remove espaços e converte o e-mail para minúsculas
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// validateEmail aceita apenas um endereço simples, sem nome de exibição
+func validateEmail(email string) error {
+	if len(email) > 255 {
+		return errInvalidEmail
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return errInvalidEmail
+	}
+	return nil
+}
+
+// validatePassword verifica o tamanho da senha informada no campo. O mínimo é contado em
+// caracteres; o máximo, em bytes.
+func validatePassword(field, password string) error {
+	if utf8.RuneCountInString(password) < MinPasswordLength {
+		return invalidField("invalid_password", field, fmt.Sprintf("senha deve ter ao menos %d caracteres", MinPasswordLength))
+	}
+	if len(password) > MaxPasswordLength {
+		return invalidField("invalid_password", field, fmt.Sprintf("senha deve ter no máximo %d bytes", MaxPasswordLength))
+	}
+	return nil
+}
+
+// normalizeRoles valida os papéis e remove repetições; sem papéis, o usuário é viewer
+func normalizeRoles(roles []entities.Role) ([]entities.Role, error) {
+	if len(roles) == 0 {
+		return []entities.Role{entities.RoleViewer}, nil
+	}
+
+	seen := make(map[entities.Role]bool, len(roles))
+	result := make([]entities.Role, 0, len(roles))
+	for _, role := range roles {
+		if !role.IsValid() {
+			return nil, invalidField("invalid_role", "roles", fmt.Sprintf("papel inválido: '%s'", role))
+		}
+		if !seen[role] {
+			seen[role] = true
+			result = append(result, role)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,240 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// memoryUserRepository guarda os usuários em memória, com a mesma regra do banco: a alteração
+// não pode deixar o catálogo sem admins ativos
+type memoryUserRepository struct {
+	repositories.UserRepository
+	users map[uint]entities.User
+}
+
+func (r *memoryUserRepository) GetByID(id uint) (*entities.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &user, nil
+}
+
+func (r *memoryUserRepository) Update(user *entities.User, fields ...string) error {
+	current := r.users[user.ID]
+	if current.IsActiveAdmin() && !user.IsActiveAdmin() {
+		others := 0
+		for id, other := range r.users {
+			if id != user.ID && other.IsActiveAdmin() {
+				others++
+			}
+		}
+		if others == 0 {
+			return repositories.ErrLastAdmin
+		}
+	}
+	r.users[user.ID] = *user
+	return nil
+}
+
+// plainHasher não calcula hash algum; basta para os testes que não envolvem senhas
+type plainHasher struct{}
+
+func (plainHasher) Hash(password string) (string, error) { return password, nil }
+
+func (plainHasher) Compare(hash, password string) error {
+	if hash != password {
+		return errors.New("senha incorreta")
+	}
+	return nil
+}
+
+// contextForUser simula uma requisição autenticada do usuário informado
+func contextForUser(id uint, roles ...entities.Role) context.Context {
+	return ContextWithClaims(context.Background(), &entities.TokenClaims{Subject: entities.UserSubject(id), Roles: roles})
+}
+
+func TestUpdateUserRoles(t *testing.T) {
+	admin := []entities.Role{entities.RoleAdmin}
+	editor := []entities.Role{entities.RoleEditor}
+
+	tests := []struct {
+		name      string
+		users     map[uint]entities.User
+		ctx       context.Context
+		id        uint
+		roles     []entities.Role
+		wantCode  string
+		wantRoles []entities.Role
+	}{
+		{
+			name:      "admin promove editor",
+			users:     map[uint]entities.User{1: {ID: 1, Roles: admin}, 2: {ID: 2, Roles: editor}},
+			ctx:       contextForUser(1, entities.RoleAdmin),
+			id:        2,
+			roles:     admin,
+			wantRoles: admin,
+		},
+		{
+			name:      "admin rebaixa outro admin",
+			users:     map[uint]entities.User{1: {ID: 1, Roles: admin}, 2: {ID: 2, Roles: admin}},
+			ctx:       contextForUser(1, entities.RoleAdmin),
+			id:        2,
+			roles:     editor,
+			wantRoles: editor,
+		},
+		{
+			name:     "admin remove o próprio papel",
+			users:    map[uint]entities.User{1: {ID: 1, Roles: admin}, 2: {ID: 2, Roles: admin}},
+			ctx:      contextForUser(1, entities.RoleAdmin),
+			id:       1,
+			roles:    editor,
+			wantCode: "cannot_demote_self",
+		},
+		{
+			name:      "admin mantém o próprio papel",
+			users:     map[uint]entities.User{1: {ID: 1, Roles: admin}},
+			ctx:       contextForUser(1, entities.RoleAdmin),
+			id:        1,
+			roles:     []entities.Role{entities.RoleEditor, entities.RoleAdmin},
+			wantRoles: []entities.Role{entities.RoleEditor, entities.RoleAdmin},
+		},
+		{
+			name: "último admin ativo",
+			users: map[uint]entities.User{
+				1: {ID: 1, Roles: admin},
+				2: {ID: 2, Roles: admin, Disabled: true},
+			},
+			// Uma integração com papel admin, que não é um usuário
+			ctx:      ContextWithClaims(context.Background(), &entities.TokenClaims{Subject: "bootstrap", Roles: admin}),
+			id:       1,
+			roles:    editor,
+			wantCode: "last_admin",
+		},
+		{
+			name:     "papel inválido",
+			users:    map[uint]entities.User{1: {ID: 1, Roles: admin}, 2: {ID: 2, Roles: editor}},
+			ctx:      contextForUser(1, entities.RoleAdmin),
+			id:       2,
+			roles:    []entities.Role{"superuser"},
+			wantCode: "invalid_role",
+		},
+		{
+			name:     "editor não gerencia usuários",
+			users:    map[uint]entities.User{1: {ID: 1, Roles: editor}, 2: {ID: 2, Roles: editor}},
+			ctx:      contextForUser(1, entities.RoleEditor),
+			id:       2,
+			roles:    admin,
+			wantCode: "permission_denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := &memoryUserRepository{users: tt.users}
+			refreshRepo := &memoryRefreshTokenRepository{}
+			authUseCase := NewAuthUseCase(fakeSigner{}, refreshRepo, userRepo, time.Hour)
+			uc := NewUserUseCase(userRepo, plainHasher{}, authUseCase, NewRoleAuthorizer())
+
+			// Uma sessão aberta do usuário alterado, que deve ser encerrada com a troca de papéis
+			session, err := authUseCase.IssueTokens(entities.UserSubject(tt.id), map[string]interface{}{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			roles := tt.roles
+			_, err = uc.UpdateUser(tt.ctx, tt.id, UserPatch{Roles: &roles})
+			if tt.wantCode != "" {
+				var ucErr *Error
+				if !errors.As(err, &ucErr) || ucErr.Code != tt.wantCode {
+					t.Fatalf("erro = %v, esperado %s", err, tt.wantCode)
+				}
+				if !reflect.DeepEqual(userRepo.users[tt.id].Roles, tt.users[tt.id].Roles) {
+					t.Errorf("papéis alterados apesar do erro: %v", userRepo.users[tt.id].Roles)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateUser retornou erro: %v", err)
+			}
+			if !reflect.DeepEqual(userRepo.users[tt.id].Roles, tt.wantRoles) {
+				t.Errorf("papéis = %v, esperado %v", userRepo.users[tt.id].Roles, tt.wantRoles)
+			}
+			if _, err := authUseCase.Refresh(session.RefreshToken); err == nil {
+				t.Error("a sessão do usuário continuou válida depois da troca de papéis")
+			}
+		})
+	}
+}
+
+func TestDisableUser(t *testing.T) {
+	admin := []entities.Role{entities.RoleAdmin}
+
+	tests := []struct {
+		name     string
+		users    map[uint]entities.User
+		ctx      context.Context
+		id       uint
+		wantCode string
+	}{
+		{
+			name:  "admin desativa editor",
+			users: map[uint]entities.User{1: {ID: 1, Roles: admin}, 2: {ID: 2, Roles: []entities.Role{entities.RoleEditor}}},
+			ctx:   contextForUser(1, entities.RoleAdmin),
+			id:    2,
+		},
+		{
+			name:  "admin desativa outro admin",
+			users: map[uint]entities.User{1: {ID: 1, Roles: admin}, 2: {ID: 2, Roles: admin}},
+			ctx:   contextForUser(1, entities.RoleAdmin),
+			id:    2,
+		},
+		{
+			name:     "própria conta",
+			users:    map[uint]entities.User{1: {ID: 1, Roles: admin}, 2: {ID: 2, Roles: admin}},
+			ctx:      contextForUser(1, entities.RoleAdmin),
+			id:       1,
+			wantCode: "cannot_disable_self",
+		},
+		{
+			name:     "último admin ativo",
+			users:    map[uint]entities.User{1: {ID: 1, Roles: admin}},
+			ctx:      ContextWithClaims(context.Background(), &entities.TokenClaims{Subject: "bootstrap", Roles: admin}),
+			id:       1,
+			wantCode: "last_admin",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := &memoryUserRepository{users: tt.users}
+			authUseCase := NewAuthUseCase(fakeSigner{}, &memoryRefreshTokenRepository{}, userRepo, time.Hour)
+			uc := NewUserUseCase(userRepo, plainHasher{}, authUseCase, NewRoleAuthorizer())
+
+			_, err := uc.DisableUser(tt.ctx, tt.id)
+			if tt.wantCode != "" {
+				var ucErr *Error
+				if !errors.As(err, &ucErr) || ucErr.Code != tt.wantCode {
+					t.Fatalf("erro = %v, esperado %s", err, tt.wantCode)
+				}
+				if userRepo.users[tt.id].Disabled {
+					t.Error("conta desativada apesar do erro")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DisableUser retornou erro: %v", err)
+			}
+
+			// Os tokens de acesso já emitidos deixam de valer
+			if _, err := authUseCase.Authenticate("access:" + entities.UserSubject(tt.id)); err == nil {
+				t.Error("o token de acesso da conta desativada continuou válido")
+			}
+		})
+	}
+}
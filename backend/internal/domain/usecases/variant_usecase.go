@@ -0,0 +1,279 @@
+package usecases
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// VariantInput representa os dados para criar ou atualizar uma variante
+type VariantInput struct {
+	SKU     string
+	Options map[string]string
+	Price   *entities.Money
+	Image   string
+}
+
+// VariantUseCase define os casos de uso para opções e variantes de produtos.
+// As consultas são feitas pelo ProductUseCase, que já carrega opções e variantes.
+type VariantUseCase interface {
+	SetOptions(ctx context.Context, productID uint, options []entities.ProductOption) ([]entities.ProductOption, error)
+	CreateVariant(ctx context.Context, productID uint, input VariantInput) (*entities.ProductVariant, error)
+	UpdateVariant(ctx context.Context, productID, variantID uint, input VariantInput) (*entities.ProductVariant, error)
+	DeleteVariant(ctx context.Context, productID, variantID uint) error
+}
+
+// variantUseCase implementa VariantUseCase
+type variantUseCase struct {
+	variantRepo repositories.VariantRepository
+	productRepo repositories.ProductRepository
+	authorizer  Authorizer
+}
+
+// NewVariantUseCase cria uma nova instância de VariantUseCase
+func NewVariantUseCase(variantRepo repositories.VariantRepository, productRepo repositories.ProductRepository, authorizer Authorizer) VariantUseCase {
+	return &variantUseCase{
+		variantRepo: variantRepo,
+		productRepo: productRepo,
+		authorizer:  authorizer,
+	}
+}
+
+// SetOptions substitui os eixos de variação de um produto.
+// A alteração é recusada se alguma variante existente deixar de ser válida.
+func (uc *variantUseCase) SetOptions(ctx context.Context, productID uint, options []entities.ProductOption) ([]entities.ProductOption, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionProductsUpdate); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.productRepo.GetByID(productID); err != nil {
+		return nil, ErrProductNotFound
+	}
+
+	// Validar nomes e valores
+	names := make(map[string]bool)
+	for i := range options {
+		options[i].Name = strings.TrimSpace(options[i].Name)
+		if options[i].Name == "" {
+			return nil, invalidField("invalid_options", "options", "nome da opção é obrigatório")
+		}
+		key := strings.ToLower(options[i].Name)
+		if names[key] {
+			return nil, invalidField("invalid_options", "options", fmt.Sprintf("opção '%s' duplicada", options[i].Name))
+		}
+		names[key] = true
+
+		if len(options[i].Values) == 0 {
+			return nil, invalidField("invalid_options", "options", fmt.Sprintf("opção '%s' deve ter ao menos um valor", options[i].Name))
+		}
+		values := make(map[string]bool)
+		for j, value := range options[i].Values {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return nil, invalidField("invalid_options", "options", fmt.Sprintf("opção '%s' possui valor vazio", options[i].Name))
+			}
+			if values[strings.ToLower(value)] {
+				return nil, invalidField("invalid_options", "options", fmt.Sprintf("valor '%s' duplicado na opção '%s'", value, options[i].Name))
+			}
+			values[strings.ToLower(value)] = true
+			options[i].Values[j] = value
+		}
+	}
+
+	// Garantir que as variantes existentes continuam válidas
+	variants, err := uc.variantRepo.GetByProductID(productID)
+	if err != nil {
+		return nil, err
+	}
+	for _, variant := range variants {
+		if _, err := normalizeVariantOptions(options, variant.Options); err != nil {
+			return nil, NewConflictError("variant_invalidated", fmt.Sprintf("a variante %s deixaria de ser válida: %v", variant.SKU, err))
+		}
+	}
+
+	if err := uc.variantRepo.ReplaceOptions(productID, options); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// findVariant busca uma variante garantindo que pertence ao produto
+func (uc *variantUseCase) findVariant(productID, variantID uint) (*entities.ProductVariant, error) {
+	variant, err := uc.variantRepo.GetByID(variantID)
+	if err != nil || variant.ProductID != productID {
+		return nil, ErrVariantNotFound
+	}
+	return variant, nil
+}
+
+// CreateVariant cria uma nova variante para o produto
+func (uc *variantUseCase) CreateVariant(ctx context.Context, productID uint, input VariantInput) (*entities.ProductVariant, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionProductsUpdate); err != nil {
+		return nil, err
+	}
+
+	product, err := uc.productRepo.GetByID(productID)
+	if err != nil {
+		return nil, ErrProductNotFound
+	}
+
+	variant := &entities.ProductVariant{ProductID: productID}
+	if err := uc.apply(product, variant, input); err != nil {
+		return nil, err
+	}
+
+	if err := uc.variantRepo.Create(variant); err != nil {
+		return nil, uc.translateDuplicate(variant, err)
+	}
+
+	return variant, nil
+}
+
+// UpdateVariant atualiza uma variante do produto
+func (uc *variantUseCase) UpdateVariant(ctx context.Context, productID, variantID uint, input VariantInput) (*entities.ProductVariant, error) {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionProductsUpdate); err != nil {
+		return nil, err
+	}
+
+	product, err := uc.productRepo.GetByID(productID)
+	if err != nil {
+		return nil, ErrProductNotFound
+	}
+
+	variant, err := uc.findVariant(productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.apply(product, variant, input); err != nil {
+		return nil, err
+	}
+
+	if err := uc.variantRepo.Update(variant); err != nil {
+		return nil, uc.translateDuplicate(variant, err)
+	}
+
+	return variant, nil
+}
+
+// DeleteVariant remove uma variante do produto
+func (uc *variantUseCase) DeleteVariant(ctx context.Context, productID, variantID uint) error {
+	if err := uc.authorizer.Authorize(ctx, entities.PermissionProductsUpdate); err != nil {
+		return err
+	}
+
+	if _, err := uc.findVariant(productID, variantID); err != nil {
+		return err
+	}
+	return uc.variantRepo.Delete(variantID)
+}
+
+// apply valida os dados de entrada e os aplica à variante
+func (uc *variantUseCase) apply(product *entities.Product, variant *entities.ProductVariant, input VariantInput) error {
+	// Validar SKU
+	sku := strings.TrimSpace(input.SKU)
+	if !skuPattern.MatchString(sku) {
+		return errInvalidSKU
+	}
+
+	// Validar a combinação de opções contra os eixos do produto
+	options, err := normalizeVariantOptions(product.Options, input.Options)
+	if err != nil {
+		return err
+	}
+
+	// Validar preço
+	if input.Price != nil {
+		if !input.Price.IsPositive() {
+			return errInvalidPrice
+		}
+		if !input.Price.IsWithinLimit() {
+			return errPriceLimit
+		}
+		if input.Price.Currency != product.Price.Currency {
+			return invalidField("currency_mismatch", "price", fmt.Sprintf("o preço da variante deve estar em %s, a moeda do produto", product.Price.Currency))
+		}
+	}
+
+	variant.SKU = sku
+	variant.Options = options
+	variant.Price = input.Price
+	variant.Image = input.Image
+
+	// SKU único entre produtos e variantes
+	if existing, err := uc.productRepo.GetBySKU(sku); err == nil && existing != nil {
+		return fieldInUse("sku", sku)
+	}
+	if existing, err := uc.variantRepo.GetBySKU(sku); err == nil && existing.ID != variant.ID {
+		return fieldInUse("sku", sku)
+	}
+
+	// Combinação de opções única por produto
+	for _, existing := range product.Variants {
+		if existing.ID != variant.ID && existing.OptionKey() == variant.OptionKey() {
+			return fieldInUse("options", variant.OptionKey())
+		}
+	}
+
+	return nil
+}
+
+// translateDuplicate converte violações de unicidade concorrentes em erros de conflito
+func (uc *variantUseCase) translateDuplicate(variant *entities.ProductVariant, err error) error {
+	var duplicate *repositories.DuplicateKeyError
+	if !errors.As(err, &duplicate) {
+		return err
+	}
+	switch duplicate.Field {
+	case "options":
+		return fieldInUse("options", variant.OptionKey())
+	case "sku":
+		return fieldInUse("sku", variant.SKU)
+	default:
+		return err
+	}
+}
+
+// normalizeVariantOptions garante que a combinação tem exatamente um valor válido
+// para cada eixo e retorna nomes e valores na grafia definida no produto
+func normalizeVariantOptions(axes []entities.ProductOption, values map[string]string) (map[string]string, error) {
+	if len(axes) == 0 {
+		return nil, invalidField("invalid_variant_options", "options", "defina as opções do produto antes de criar variantes")
+	}
+	if len(values) != len(axes) {
+		return nil, invalidField("invalid_variant_options", "options", fmt.Sprintf("informe exatamente um valor para cada opção (%d)", len(axes)))
+	}
+
+	normalized := make(map[string]string, len(axes))
+	for _, axis := range axes {
+		var value string
+		found := false
+		for name, v := range values {
+			if strings.EqualFold(name, axis.Name) {
+				value, found = v, true
+				break
+			}
+		}
+		if !found {
+			return nil, invalidField("invalid_variant_options", "options", fmt.Sprintf("valor da opção '%s' é obrigatório", axis.Name))
+		}
+
+		valid := false
+		for _, allowed := range axis.Values {
+			if strings.EqualFold(strings.TrimSpace(value), allowed) {
+				normalized[axis.Name] = allowed
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, invalidField("invalid_variant_options", "options", fmt.Sprintf("valor '%s' inválido para a opção '%s'", value, axis.Name))
+		}
+	}
+
+	return normalized, nil
+}
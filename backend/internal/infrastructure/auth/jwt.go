@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"catalogo-produtos/backend/internal/config"
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minSecretLength é o tamanho mínimo da chave do HS256, em bytes
+const minSecretLength = 32
+
+// clockSkew é a tolerância na validação de exp, nbf e iat entre servidores
+const clockSkew = 30 * time.Second
+
+// registeredClaims são as claims preenchidas pelo próprio signer, fora de TokenClaims.Extra
+var registeredClaims = map[string]bool{
+	"iss": true, "sub": true, "aud": true, "exp": true, "nbf": true, "iat": true, "jti": true,
+}
+
+// jwtSigner implementa usecases.TokenSigner com JWTs HS256 ou RS256
+type jwtSigner struct {
+	method jwt.SigningMethod
+	// signingKey é a chave de assinatura ([]byte ou *rsa.PrivateKey); nil se o servidor apenas valida tokens
+	signingKey interface{}
+	// verifyKey é a chave de validação de tokens sem kid ou com o kid próprio ([]byte ou *rsa.PublicKey)
+	verifyKey interface{}
+	// jwks são as chaves públicas do JWK Set, pelo kid
+	jwks     map[string]*rsa.PublicKey
+	keyID    string
+	issuer   string
+	audience string
+	ttl      time.Duration
+}
+
+// NewJWTSigner cria o signer a partir das configurações de autenticação
+func NewJWTSigner(cfg config.AuthConfig) (usecases.TokenSigner, error) {
+	signer := &jwtSigner{
+		keyID:    cfg.KeyID,
+		issuer:   cfg.Issuer,
+		audience: cfg.Audience,
+		ttl:      cfg.AccessTokenTTL,
+	}
+
+	switch cfg.Algorithm {
+	case "HS256":
+		if len(cfg.Secret) < minSecretLength {
+			return nil, fmt.Errorf("AUTH_JWT_SECRET deve ter pelo menos %d bytes com HS256", minSecretLength)
+		}
+		signer.method = jwt.SigningMethodHS256
+		signer.signingKey = []byte(cfg.Secret)
+		signer.verifyKey = []byte(cfg.Secret)
+	case "RS256":
+		signer.method = jwt.SigningMethodRS256
+		if err := signer.loadRSAKeys(cfg); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("AUTH_JWT_ALGORITHM inválido: %s (use HS256 ou RS256)", cfg.Algorithm)
+	}
+
+	return signer, nil
+}
+
+// loadRSAKeys carrega a chave privada, a chave pública e o JWK Set configurados
+func (s *jwtSigner) loadRSAKeys(cfg config.AuthConfig) error {
+	if cfg.PrivateKeyFile != "" {
+		data, err := os.ReadFile(cfg.PrivateKeyFile)
+		if err != nil {
+			return fmt.Errorf("erro ao ler AUTH_JWT_PRIVATE_KEY_FILE: %w", err)
+		}
+		privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+		if err != nil {
+			return fmt.Errorf("AUTH_JWT_PRIVATE_KEY_FILE inválido: %w", err)
+		}
+		s.signingKey = privateKey
+		s.verifyKey = &privateKey.PublicKey
+	}
+
+	if cfg.PublicKeyFile != "" {
+		data, err := os.ReadFile(cfg.PublicKeyFile)
+		if err != nil {
+			return fmt.Errorf("erro ao ler AUTH_JWT_PUBLIC_KEY_FILE: %w", err)
+		}
+		publicKey, err := jwt.ParseRSAPublicKeyFromPEM(data)
+		if err != nil {
+			return fmt.Errorf("AUTH_JWT_PUBLIC_KEY_FILE inválido: %w", err)
+		}
+		s.verifyKey = publicKey
+	}
+
+	if cfg.JWKSFile != "" {
+		data, err := os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return fmt.Errorf("erro ao ler AUTH_JWKS_FILE: %w", err)
+		}
+		if s.jwks, err = parseJWKS(data); err != nil {
+			return fmt.Errorf("AUTH_JWKS_FILE inválido: %w", err)
+		}
+	}
+
+	if s.verifyKey == nil && len(s.jwks) == 0 {
+		return errors.New("RS256 exige AUTH_JWT_PRIVATE_KEY_FILE, AUTH_JWT_PUBLIC_KEY_FILE ou AUTH_JWKS_FILE")
+	}
+	return nil
+}
+
+// Sign emite um token de acesso com as claims registradas e as adicionais
+func (s *jwtSigner) Sign(subject string, extra map[string]interface{}) (string, time.Time, error) {
+	if s.signingKey == nil {
+		return "", time.Time{}, errors.New("nenhuma chave de assinatura configurada (AUTH_JWT_PRIVATE_KEY_FILE)")
+	}
+
+	tokenID, err := randomID()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.ttl)
+	claims := jwt.MapClaims{}
+	for key, value := range extra {
+		if !registeredClaims[key] {
+			claims[key] = value
+		}
+	}
+	claims["iss"] = s.issuer
+	claims["sub"] = subject
+	claims["aud"] = s.audience
+	claims["iat"] = now.Unix()
+	claims["exp"] = expiresAt.Unix()
+	claims["jti"] = tokenID
+
+	token := jwt.NewWithClaims(s.method, claims)
+	if s.keyID != "" {
+		token.Header["kid"] = s.keyID
+	}
+
+	signed, err := token.SignedString(s.signingKey)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+// Verify valida o token e extrai as claims. Apenas o algoritmo configurado é aceito, o que
+// impede tokens com alg "none" ou um HS256 assinado com a chave pública do RS256.
+func (s *jwtSigner) Verify(tokenString string) (*entities.TokenClaims, error) {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{s.method.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithAudience(s.audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+
+	claims := jwt.MapClaims{}
+	if _, err := parser.ParseWithClaims(tokenString, claims, s.keyFor); err != nil {
+		return nil, err
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return nil, errors.New("token sem sub")
+	}
+
+	result := &entities.TokenClaims{Subject: subject, Extra: map[string]interface{}{}}
+	result.TokenID, _ = claims["jti"].(string)
+	if issuedAt, err := claims.GetIssuedAt(); err == nil && issuedAt != nil {
+		result.IssuedAt = issuedAt.Time
+	}
+	if expiresAt, err := claims.GetExpirationTime(); err == nil && expiresAt != nil {
+		result.ExpiresAt = expiresAt.Time
+	}
+	result.Roles = rolesFrom(claims[entities.RolesClaim])
+	for key, value := range claims {
+		if !registeredClaims[key] && key != entities.RolesClaim {
+			result.Extra[key] = value
+		}
+	}
+	return result, nil
+}
+
+// rolesFrom lê a claim roles, uma lista de strings, ignorando papéis desconhecidos
+func rolesFrom(value interface{}) []entities.Role {
+	values, _ := value.([]interface{})
+	roles := make([]entities.Role, 0, len(values))
+	for _, value := range values {
+		name, _ := value.(string)
+		if role := entities.Role(name); role.IsValid() {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// keyFor escolhe a chave de validação: a do JWK Set pelo kid do token ou, sem kid ou com o
+// kid próprio, a chave configurada
+func (s *jwtSigner) keyFor(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if key, ok := s.jwks[kid]; ok && kid != "" {
+		return key, nil
+	}
+	if s.verifyKey != nil && (kid == "" || kid == s.keyID) {
+		return s.verifyKey, nil
+	}
+	return nil, fmt.Errorf("chave desconhecida: %q", kid)
+}
+
+// randomID gera o identificador único (jti) de um token
+func randomID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
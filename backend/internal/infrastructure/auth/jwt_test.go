@@ -0,0 +1,262 @@
+package auth
+
+import (
+	"catalogo-produtos/backend/internal/config"
+	"catalogo-produtos/backend/internal/domain/entities"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "segredo-de-teste-com-mais-de-32-bytes"
+
+// testAuthConfig retorna uma configuração HS256 válida
+func testAuthConfig() config.AuthConfig {
+	return config.AuthConfig{
+		Algorithm:      "HS256",
+		Secret:         testSecret,
+		Issuer:         "catalogo-produtos",
+		Audience:       "catalogo-api",
+		AccessTokenTTL: 15 * time.Minute,
+	}
+}
+
+// validClaims retorna as claims de um token aceito pela configuração de teste
+func validClaims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss": "catalogo-produtos",
+		"aud": "catalogo-api",
+		"sub": "user:1",
+		"iat": now.Unix(),
+		"exp": now.Add(time.Minute).Unix(),
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	signer, err := NewJWTSigner(testAuthConfig())
+	if err != nil {
+		t.Fatalf("NewJWTSigner retornou erro: %v", err)
+	}
+
+	token, expiresAt, err := signer.Sign("user:42", map[string]interface{}{
+		entities.RolesClaim: []string{"editor", "superuser"},
+		"email":             "ana@example.com",
+		// Claims registradas nas extras não substituem as do signer
+		"sub": "user:1",
+		"iss": "outro",
+	})
+	if err != nil {
+		t.Fatalf("Sign retornou erro: %v", err)
+	}
+
+	claims, err := signer.Verify(token)
+	if err != nil {
+		t.Fatalf("Verify retornou erro: %v", err)
+	}
+	if claims.Subject != "user:42" {
+		t.Errorf("Subject = %q, esperado user:42", claims.Subject)
+	}
+	if !reflect.DeepEqual(claims.Roles, []entities.Role{entities.RoleEditor}) {
+		t.Errorf("Roles = %v, esperado apenas os papéis conhecidos", claims.Roles)
+	}
+	if claims.Extra["email"] != "ana@example.com" {
+		t.Errorf("Extra = %v, esperado o e-mail", claims.Extra)
+	}
+	if claims.TokenID == "" {
+		t.Error("token sem jti")
+	}
+	if !claims.ExpiresAt.Equal(expiresAt.Truncate(time.Second)) {
+		t.Errorf("ExpiresAt = %v, esperado %v", claims.ExpiresAt, expiresAt)
+	}
+}
+
+func TestVerifyRejectsInvalidTokens(t *testing.T) {
+	signer, err := NewJWTSigner(testAuthConfig())
+	if err != nil {
+		t.Fatalf("NewJWTSigner retornou erro: %v", err)
+	}
+
+	sign := func(method jwt.SigningMethod, key interface{}, change func(jwt.MapClaims)) string {
+		claims := validClaims()
+		if change != nil {
+			change(claims)
+		}
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		if err != nil {
+			t.Fatalf("erro ao assinar o token de teste: %v", err)
+		}
+		return token
+	}
+	secret := []byte(testSecret)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("erro ao gerar a chave RSA: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "válido", token: sign(jwt.SigningMethodHS256, secret, nil)},
+		{
+			name:  "expirado dentro da tolerância",
+			token: sign(jwt.SigningMethodHS256, secret, func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-10 * time.Second).Unix() }),
+		},
+		{
+			name:    "expirado",
+			token:   sign(jwt.SigningMethodHS256, secret, func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }),
+			wantErr: true,
+		},
+		{
+			name:    "sem exp",
+			token:   sign(jwt.SigningMethodHS256, secret, func(c jwt.MapClaims) { delete(c, "exp") }),
+			wantErr: true,
+		},
+		{
+			name:    "emitido no futuro",
+			token:   sign(jwt.SigningMethodHS256, secret, func(c jwt.MapClaims) { c["iat"] = time.Now().Add(time.Hour).Unix() }),
+			wantErr: true,
+		},
+		{
+			name:    "outro emissor",
+			token:   sign(jwt.SigningMethodHS256, secret, func(c jwt.MapClaims) { c["iss"] = "outro" }),
+			wantErr: true,
+		},
+		{
+			name:    "sem emissor",
+			token:   sign(jwt.SigningMethodHS256, secret, func(c jwt.MapClaims) { delete(c, "iss") }),
+			wantErr: true,
+		},
+		{
+			name:    "outra audiência",
+			token:   sign(jwt.SigningMethodHS256, secret, func(c jwt.MapClaims) { c["aud"] = "outra-api" }),
+			wantErr: true,
+		},
+		{
+			name:  "audiência em lista",
+			token: sign(jwt.SigningMethodHS256, secret, func(c jwt.MapClaims) { c["aud"] = []string{"outra-api", "catalogo-api"} }),
+		},
+		{
+			name:    "sem sub",
+			token:   sign(jwt.SigningMethodHS256, secret, func(c jwt.MapClaims) { delete(c, "sub") }),
+			wantErr: true,
+		},
+		{
+			name:    "outra chave",
+			token:   sign(jwt.SigningMethodHS256, []byte("outro-segredo-com-mais-de-32-bytes!!"), nil),
+			wantErr: true,
+		},
+		{
+			name:    "alg none",
+			token:   sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, nil),
+			wantErr: true,
+		},
+		{
+			name:    "outro algoritmo HMAC",
+			token:   sign(jwt.SigningMethodHS512, secret, nil),
+			wantErr: true,
+		},
+		{
+			name:    "RS256 em servidor HS256",
+			token:   sign(jwt.SigningMethodRS256, rsaKey, nil),
+			wantErr: true,
+		},
+		{name: "malformado", token: "nao.e.jwt", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := signer.Verify(tt.token)
+			if tt.wantErr && err == nil {
+				t.Fatal("Verify aceitou um token inválido")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("Verify retornou erro: %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyRS256RejectsAlgorithmConfusion(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("erro ao gerar a chave RSA: %v", err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: mustMarshalPublicKey(t, &key.PublicKey)})
+	publicKeyFile := filepath.Join(t.TempDir(), "public.pem")
+	if err := os.WriteFile(publicKeyFile, publicPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := testAuthConfig()
+	cfg.Algorithm = "RS256"
+	cfg.Secret = ""
+	cfg.PublicKeyFile = publicKeyFile
+	signer, err := NewJWTSigner(cfg)
+	if err != nil {
+		t.Fatalf("NewJWTSigner retornou erro: %v", err)
+	}
+
+	valid, err := jwt.NewWithClaims(jwt.SigningMethodRS256, validClaims()).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signer.Verify(valid); err != nil {
+		t.Fatalf("Verify recusou um token RS256 válido: %v", err)
+	}
+
+	// Um HS256 assinado com a chave pública, que é conhecida, não pode ser aceito
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString(publicPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signer.Verify(forged); err == nil {
+		t.Fatal("Verify aceitou um HS256 assinado com a chave pública")
+	}
+
+	// Sem chave privada, o servidor apenas valida tokens
+	if _, _, err := signer.Sign("user:1", nil); err == nil {
+		t.Error("Sign sem chave privada deveria falhar")
+	}
+}
+
+func TestNewJWTSignerRejectsInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(*config.AuthConfig)
+	}{
+		{name: "segredo curto", change: func(c *config.AuthConfig) { c.Secret = "curto" }},
+		{name: "algoritmo desconhecido", change: func(c *config.AuthConfig) { c.Algorithm = "none" }},
+		{name: "RS256 sem chaves", change: func(c *config.AuthConfig) { c.Algorithm = "RS256" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testAuthConfig()
+			tt.change(&cfg)
+			if _, err := NewJWTSigner(cfg); err == nil {
+				t.Fatal("NewJWTSigner aceitou uma configuração inválida")
+			}
+		})
+	}
+}
+
+// mustMarshalPublicKey codifica a chave pública em PKIX
+func mustMarshalPublicKey(t *testing.T, key *rsa.PublicKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return der
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"catalogo-produtos/backend/internal/domain/usecases"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcryptHasher implementa usecases.PasswordHasher com bcrypt
+type bcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher cria um PasswordHasher bcrypt; custos fora do intervalo aceito usam o padrão
+func NewBcryptHasher(cost int) usecases.PasswordHasher {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
+	return &bcryptHasher{cost: cost}
+}
+
+// Hash calcula o hash da senha, com salt aleatório
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// Compare verifica a senha em tempo constante
+func (h *bcryptHasher) Compare(hash, password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// APIKeyModel representa o modelo de banco de dados para chaves de API. Apenas o hash
+// SHA-256 da chave é armazenado.
+type APIKeyModel struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	Name       string     `json:"name" gorm:"not null;size:255"`
+	Prefix     string     `json:"prefix" gorm:"not null;size:16"`
+	KeyHash    string     `json:"-" gorm:"not null;size:64;uniqueIndex"`
+	Scopes     StringList `json:"scopes" gorm:"type:jsonb;not null"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedBy  string     `json:"created_by" gorm:"not null;size:255"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// TableName especifica o nome da tabela
+func (APIKeyModel) TableName() string {
+	return "api_keys"
+}
@@ -0,0 +1,19 @@
+package models
+
+// CategoryAttributeModel representa o modelo de banco de dados para atributos de categorias
+type CategoryAttributeModel struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	CategoryID uint       `json:"category_id" gorm:"not null;uniqueIndex:idx_category_attributes_key"`
+	Key        string     `json:"key" gorm:"not null;size:64;uniqueIndex:idx_category_attributes_key"`
+	Label      string     `json:"label" gorm:"not null;size:255"`
+	Type       string     `json:"type" gorm:"not null;size:20"`
+	Unit       string     `json:"unit" gorm:"size:20"`
+	Required   bool       `json:"required" gorm:"not null;default:false"`
+	Options    StringList `json:"options" gorm:"type:jsonb"`
+	Position   int        `json:"position" gorm:"not null;default:0"`
+}
+
+// TableName especifica o nome da tabela
+func (CategoryAttributeModel) TableName() string {
+	return "category_attributes"
+}
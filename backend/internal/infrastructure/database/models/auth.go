@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// RefreshTokenModel representa o modelo de banco de dados para refresh tokens. Apenas o hash
+// SHA-256 do token é armazenado.
+type RefreshTokenModel struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	FamilyID     string     `json:"family_id" gorm:"not null;size:64;index"`
+	Subject      string     `json:"subject" gorm:"not null;size:255;index"`
+	TokenHash    string     `json:"-" gorm:"not null;size:64;uniqueIndex"`
+	Claims       JSONMap    `json:"claims" gorm:"type:jsonb"`
+	ExpiresAt    time.Time  `json:"expires_at" gorm:"not null"`
+	RevokedAt    *time.Time `json:"revoked_at"`
+	ReplacedByID *uint      `json:"replaced_by_id"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// TableName especifica o nome da tabela
+func (RefreshTokenModel) TableName() string {
+	return "refresh_tokens"
+}
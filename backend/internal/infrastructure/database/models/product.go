@@ -8,16 +8,31 @@ import (
 
 // ProductModel representa o modelo de banco de dados para produtos
 type ProductModel struct {
-	ID          uint           `json:"id" gorm:"primaryKey"`
-	Name        string         `json:"name" gorm:"not null;size:255"`
-	Image       string         `json:"image" gorm:"size:500"`
-	Price       float64        `json:"price" gorm:"not null;type:decimal(10,2)"`
-	CategoryID  uint           `json:"category_id" gorm:"not null"`
-	Category    CategoryModel  `json:"category" gorm:"foreignKey:CategoryID"`
-	Description string         `json:"description" gorm:"type:text"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`
+	ID          uint          `json:"id" gorm:"primaryKey"`
+	SKU         *string       `json:"sku" gorm:"column:sku;size:64"`
+	Slug        string        `json:"slug" gorm:"size:255"`
+	Name        string        `json:"name" gorm:"not null;size:255"`
+	Image       string        `json:"image" gorm:"size:500"`
+	Price       string        `json:"price" gorm:"not null;type:decimal(10,2)"`
+	Currency    string        `json:"currency" gorm:"not null;size:3;default:BRL"`
+	CategoryID  uint          `json:"category_id" gorm:"not null"`
+	Category    CategoryModel `json:"category" gorm:"foreignKey:CategoryID"`
+	Description string        `json:"description" gorm:"type:text"`
+	Attributes  JSONMap       `json:"attributes" gorm:"type:jsonb"`
+	// Somente leitura para o GORM: alterado apenas pelas movimentações de estoque
+	StockQuantity int64                 `json:"stock_quantity" gorm:"->;not null;default:0"`
+	Options       []ProductOptionModel  `json:"options" gorm:"foreignKey:ProductID"`
+	Variants      []ProductVariantModel `json:"variants" gorm:"foreignKey:ProductID"`
+	// Version é incrementada por gatilho do banco (ver db.Migrate)
+	Version   int64          `json:"version" gorm:"not null;default:1"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`
+
+	// Colunas calculadas pela busca textual (search_vector é mantida por migração)
+	SearchRank        float64 `json:"-" gorm:"->;-:migration"`
+	SearchName        string  `json:"-" gorm:"->;-:migration"`
+	SearchDescription string  `json:"-" gorm:"->;-:migration"`
 }
 
 // TableName especifica o nome da tabela
@@ -27,8 +42,14 @@ func (ProductModel) TableName() string {
 
 // CategoryModel representa o modelo de banco de dados para categorias
 type CategoryModel struct {
-	ID        uint           `json:"id" gorm:"primaryKey"`
-	Name      string         `json:"name" gorm:"not null;size:255;unique"`
+	ID       uint           `json:"id" gorm:"primaryKey"`
+	Name     string         `json:"name" gorm:"not null;size:255;unique"`
+	ParentID *uint          `json:"parent_id" gorm:"index"`
+	Parent   *CategoryModel `json:"-" gorm:"foreignKey:ParentID"`
+	// Attributes não é usado como associação; existe para criar a chave estrangeira
+	Attributes []CategoryAttributeModel `json:"-" gorm:"foreignKey:CategoryID"`
+	// Version é incrementada por gatilho do banco (ver db.Migrate)
+	Version   int64          `json:"version" gorm:"not null;default:1"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`
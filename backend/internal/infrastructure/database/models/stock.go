@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// StockMovementModel representa o modelo de banco de dados do livro-razão de estoque.
+// A tabela é somente inserção: alterações e remoções são bloqueadas por trigger.
+type StockMovementModel struct {
+	ID           uint                 `json:"id" gorm:"primaryKey"`
+	ProductID    uint                 `json:"product_id" gorm:"not null;index"`
+	Product      *ProductModel        `json:"-" gorm:"foreignKey:ProductID"`
+	VariantID    *uint                `json:"variant_id" gorm:"index"`
+	Variant      *ProductVariantModel `json:"-" gorm:"foreignKey:VariantID"`
+	Type         string               `json:"type" gorm:"not null;size:20"`
+	Quantity     int64                `json:"quantity" gorm:"not null"`
+	BalanceAfter int64                `json:"balance_after" gorm:"not null"`
+	Note         string               `json:"note" gorm:"size:500"`
+	CreatedAt    time.Time            `json:"created_at"`
+}
+
+// TableName especifica o nome da tabela
+func (StockMovementModel) TableName() string {
+	return "stock_movements"
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// StringList armazena uma lista de strings em uma coluna jsonb
+type StringList []string
+
+// Value implementa driver.Valuer
+func (l StringList) Value() (driver.Value, error) {
+	if l == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(l)
+	return string(data), err
+}
+
+// Scan implementa sql.Scanner
+func (l *StringList) Scan(value interface{}) error {
+	return scanJSON(value, l)
+}
+
+// StringMap armazena um mapa de strings em uma coluna jsonb
+type StringMap map[string]string
+
+// Value implementa driver.Valuer
+func (m StringMap) Value() (driver.Value, error) {
+	if m == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(m)
+	return string(data), err
+}
+
+// Scan implementa sql.Scanner
+func (m *StringMap) Scan(value interface{}) error {
+	return scanJSON(value, m)
+}
+
+// JSONMap armazena um objeto JSON arbitrário em uma coluna jsonb
+type JSONMap map[string]interface{}
+
+// Value implementa driver.Valuer
+func (m JSONMap) Value() (driver.Value, error) {
+	if m == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(m)
+	return string(data), err
+}
+
+// Scan implementa sql.Scanner
+func (m *JSONMap) Scan(value interface{}) error {
+	return scanJSON(value, m)
+}
+
+// scanJSON decodifica o valor de uma coluna jsonb
+func scanJSON(value interface{}, dest interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, dest)
+	case string:
+		return json.Unmarshal([]byte(v), dest)
+	default:
+		return fmt.Errorf("tipo não suportado para coluna jsonb: %T", value)
+	}
+}
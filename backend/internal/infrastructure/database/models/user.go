@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// UserModel representa o modelo de banco de dados para usuários
+type UserModel struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	Email        string     `json:"email" gorm:"not null;size:255;uniqueIndex"`
+	Name         string     `json:"name" gorm:"not null;size:255"`
+	PasswordHash string     `json:"-" gorm:"not null;size:255"`
+	Roles        StringList `json:"roles" gorm:"type:jsonb;not null"`
+	Disabled     bool       `json:"disabled" gorm:"not null;default:false"`
+	LastLoginAt  *time.Time `json:"last_login_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// TableName especifica o nome da tabela
+func (UserModel) TableName() string {
+	return "users"
+}
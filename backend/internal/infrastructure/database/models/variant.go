@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ProductOptionModel representa o modelo de banco de dados para os eixos de variação de um produto
+type ProductOptionModel struct {
+	ID        uint       `json:"id" gorm:"primaryKey"`
+	ProductID uint       `json:"product_id" gorm:"not null;index"`
+	Name      string     `json:"name" gorm:"not null;size:100"`
+	Position  int        `json:"position" gorm:"not null;default:0"`
+	Values    StringList `json:"values" gorm:"type:jsonb;not null"`
+}
+
+// TableName especifica o nome da tabela
+func (ProductOptionModel) TableName() string {
+	return "product_options"
+}
+
+// ProductVariantModel representa o modelo de banco de dados para variantes de produtos
+type ProductVariantModel struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ProductID uint      `json:"product_id" gorm:"not null;index"`
+	SKU       string    `json:"sku" gorm:"column:sku;not null;size:64"`
+	Options   StringMap `json:"options" gorm:"type:jsonb;not null"`
+	OptionKey string    `json:"option_key" gorm:"not null;size:500"`
+	Price     *string   `json:"price" gorm:"type:decimal(10,2)"`
+	Currency  string    `json:"currency" gorm:"not null;size:3;default:BRL"`
+	Image     string    `json:"image" gorm:"size:500"`
+	// Somente leitura para o GORM: alterado apenas pelas movimentações de estoque
+	StockQuantity int64          `json:"stock_quantity" gorm:"->;not null;default:0"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`
+}
+
+// TableName especifica o nome da tabela
+func (ProductVariantModel) TableName() string {
+	return "product_variants"
+}
@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"catalogo-produtos/backend/internal/infrastructure/database/models"
+
+	"gorm.io/gorm"
+)
+
+// apiKeyRepository implementa APIKeyRepository
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyRepository cria uma nova instância de APIKeyRepository
+func NewAPIKeyRepository(db *gorm.DB) repositories.APIKeyRepository {
+	return &apiKeyRepository{db: db}
+}
+
+// Create registra uma chave de API
+func (r *apiKeyRepository) Create(key *entities.APIKey) error {
+	model := r.mapToModel(key)
+	if err := r.db.Create(model).Error; err != nil {
+		return translateError(err)
+	}
+
+	key.ID = model.ID
+	key.CreatedAt = model.CreatedAt
+	key.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+// GetByID busca uma chave por ID
+func (r *apiKeyRepository) GetByID(id uint) (*entities.APIKey, error) {
+	var model models.APIKeyModel
+	if err := r.db.First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return r.mapToEntity(&model), nil
+}
+
+// GetByHash busca uma chave pelo hash
+func (r *apiKeyRepository) GetByHash(hash string) (*entities.APIKey, error) {
+	var model models.APIKeyModel
+	if err := r.db.Where("key_hash = ?", hash).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return r.mapToEntity(&model), nil
+}
+
+// GetAll busca todas as chaves, das mais recentes para as mais antigas
+func (r *apiKeyRepository) GetAll() ([]entities.APIKey, error) {
+	var keyModels []models.APIKeyModel
+	if err := r.db.Order("id DESC").Find(&keyModels).Error; err != nil {
+		return nil, err
+	}
+
+	keys := make([]entities.APIKey, len(keyModels))
+	for i, model := range keyModels {
+		keys[i] = *r.mapToEntity(&model)
+	}
+	return keys, nil
+}
+
+// apiKeyColumns mapeia os campos editáveis da chave para as colunas persistidas
+var apiKeyColumns = map[string][]string{
+	"name":         {"name"},
+	"scopes":       {"scopes"},
+	"key":          {"prefix", "key_hash"},
+	"expires_at":   {"expires_at"},
+	"last_used_at": {"last_used_at"},
+	"revoked_at":   {"revoked_at"},
+}
+
+// Update atualiza os campos informados de uma chave
+func (r *apiKeyRepository) Update(key *entities.APIKey, fields ...string) error {
+	if len(fields) == 0 {
+		fields = []string{"name", "scopes", "key", "expires_at", "last_used_at", "revoked_at"}
+	}
+
+	model := r.mapToModel(key)
+	model.ID = key.ID
+	if err := updateColumns(r.db, model, columnsFor(fields, apiKeyColumns)); err != nil {
+		return err
+	}
+
+	key.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+// mapToModel converte entidade para modelo
+func (r *apiKeyRepository) mapToModel(key *entities.APIKey) *models.APIKeyModel {
+	scopes := make(models.StringList, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+	return &models.APIKeyModel{
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		KeyHash:    key.KeyHash,
+		Scopes:     scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedBy:  key.CreatedBy,
+	}
+}
+
+// mapToEntity converte modelo para entidade
+func (r *apiKeyRepository) mapToEntity(model *models.APIKeyModel) *entities.APIKey {
+	scopes := make([]entities.Scope, len(model.Scopes))
+	for i, scope := range model.Scopes {
+		scopes[i] = entities.Scope(scope)
+	}
+	return &entities.APIKey{
+		ID:         model.ID,
+		Name:       model.Name,
+		Prefix:     model.Prefix,
+		KeyHash:    model.KeyHash,
+		Scopes:     scopes,
+		ExpiresAt:  model.ExpiresAt,
+		LastUsedAt: model.LastUsedAt,
+		RevokedAt:  model.RevokedAt,
+		CreatedBy:  model.CreatedBy,
+		CreatedAt:  model.CreatedAt,
+		UpdatedAt:  model.UpdatedAt,
+	}
+}
@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"catalogo-produtos/backend/internal/infrastructure/database/models"
+
+	"gorm.io/gorm"
+)
+
+// attributeRepository implementa AttributeRepository
+type attributeRepository struct {
+	db *gorm.DB
+}
+
+// NewAttributeRepository cria uma nova instância de AttributeRepository
+func NewAttributeRepository(db *gorm.DB) repositories.AttributeRepository {
+	return &attributeRepository{db: db}
+}
+
+// GetByCategoryIDs busca os atributos definidos pelas categorias informadas
+func (r *attributeRepository) GetByCategoryIDs(categoryIDs []uint) ([]entities.CategoryAttribute, error) {
+	if len(categoryIDs) == 0 {
+		return []entities.CategoryAttribute{}, nil
+	}
+
+	var models []models.CategoryAttributeModel
+	err := r.db.Where("category_id IN ?", categoryIDs).Order("position, id").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make([]entities.CategoryAttribute, len(models))
+	for i := range models {
+		attributes[i] = r.mapToEntity(&models[i])
+	}
+
+	return attributes, nil
+}
+
+// ReplaceForCategory substitui todos os atributos definidos por uma categoria
+func (r *attributeRepository) ReplaceForCategory(categoryID uint, attributes []entities.CategoryAttribute) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("category_id = ?", categoryID).Delete(&models.CategoryAttributeModel{}).Error; err != nil {
+			return err
+		}
+
+		for i := range attributes {
+			model := &models.CategoryAttributeModel{
+				CategoryID: categoryID,
+				Key:        attributes[i].Key,
+				Label:      attributes[i].Label,
+				Type:       string(attributes[i].Type),
+				Unit:       attributes[i].Unit,
+				Required:   attributes[i].Required,
+				Options:    models.StringList(attributes[i].Options),
+				Position:   i,
+			}
+			if err := tx.Create(model).Error; err != nil {
+				return translateError(err)
+			}
+
+			attributes[i].ID = model.ID
+			attributes[i].CategoryID = categoryID
+			attributes[i].Position = i
+		}
+
+		return nil
+	})
+}
+
+// mapToEntity converte modelo para entidade
+func (r *attributeRepository) mapToEntity(model *models.CategoryAttributeModel) entities.CategoryAttribute {
+	options := []string(model.Options)
+	if options == nil {
+		options = []string{}
+	}
+
+	return entities.CategoryAttribute{
+		ID:         model.ID,
+		CategoryID: model.CategoryID,
+		Key:        model.Key,
+		Label:      model.Label,
+		Type:       entities.AttributeType(model.Type),
+		Unit:       model.Unit,
+		Required:   model.Required,
+		Options:    options,
+		Position:   model.Position,
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"catalogo-produtos/backend/internal/domain/entities"
 	"catalogo-produtos/backend/internal/domain/repositories"
 	"catalogo-produtos/backend/internal/infrastructure/database/models"
+	"slices"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // categoryRepository implementa CategoryRepository
@@ -21,16 +23,26 @@ func NewCategoryRepository(db *gorm.DB) repositories.CategoryRepository {
 // Create cria uma nova categoria
 func (r *categoryRepository) Create(category *entities.Category) error {
 	model := &models.CategoryModel{
-		Name: category.Name,
+		Name:     category.Name,
+		ParentID: category.ParentID,
 	}
 
-	err := r.db.Create(model).Error
+	// A categoria pai fica bloqueada até o fim da transação, para que não seja removida antes da subcategoria existir
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if model.ParentID != nil {
+			if err := lockCategory(tx, *model.ParentID); err != nil {
+				return err
+			}
+		}
+		return translateError(tx.Create(model).Error)
+	})
 	if err != nil {
 		return err
 	}
 
 	// Atualizar o ID da categoria criada
 	category.ID = model.ID
+	category.Version = model.Version
 	category.CreatedAt = model.CreatedAt
 	category.UpdatedAt = model.UpdatedAt
 
@@ -48,6 +60,17 @@ func (r *categoryRepository) GetByID(id uint) (*entities.Category, error) {
 	return r.mapToEntity(&model), nil
 }
 
+// GetByName busca uma categoria pelo nome, sem diferenciar maiúsculas
+func (r *categoryRepository) GetByName(name string) (*entities.Category, error) {
+	var model models.CategoryModel
+	err := r.db.Where("LOWER(name) = LOWER(?)", name).First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapToEntity(&model), nil
+}
+
 // GetAll busca todas as categorias
 func (r *categoryRepository) GetAll() ([]entities.Category, error) {
 	var models []models.CategoryModel
@@ -65,34 +88,246 @@ func (r *categoryRepository) GetAll() ([]entities.Category, error) {
 	return categories, nil
 }
 
-// Update atualiza uma categoria
-func (r *categoryRepository) Update(category *entities.Category) error {
+// categoryColumns mapeia os campos editáveis da categoria para as colunas persistidas
+var categoryColumns = map[string][]string{
+	"name":      {"name"},
+	"parent_id": {"parent_id"},
+}
+
+// Update atualiza os campos informados de uma categoria
+func (r *categoryRepository) Update(category *entities.Category, fields ...string) error {
+	if len(fields) == 0 {
+		fields = []string{"name", "parent_id"}
+	}
+
 	model := &models.CategoryModel{
-		ID:   category.ID,
-		Name: category.Name,
+		ID:       category.ID,
+		Name:     category.Name,
+		ParentID: category.ParentID,
 	}
 
-	err := r.db.Save(model).Error
+	// Com a versão lida, a atualização só é aplicada se ninguém alterou a categoria nesse meio tempo
+	columns := columnsFor(fields, categoryColumns)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if slices.Contains(columns, "parent_id") && model.ParentID != nil {
+			if err := lockReparent(tx, model.ID, *model.ParentID); err != nil {
+				return err
+			}
+		}
+		if category.Version > 0 {
+			return updateVersioned(tx, model, category.ID, category.Version, columns)
+		}
+		return updateColumns(tx, model, columns)
+	})
 	if err != nil {
 		return err
 	}
 
-	// Atualizar timestamps
+	// Atualizar versão e timestamps
+	category.Version = model.Version
 	category.UpdatedAt = model.UpdatedAt
 
 	return nil
 }
 
+// lockReparent bloqueia a categoria movida e a cadeia da nova categoria pai até a raiz, na ordem dos
+// IDs, e só então verifica o ciclo: reparentações concorrentes que envolvam as mesmas categorias (ex:
+// A sob B e B sob A) são serializadas, e a segunda vê a árvore já alterada pela primeira
+func lockReparent(tx *gorm.DB, id, parentID uint) error {
+	var locked []uint
+	err := tx.Raw(`
+		WITH RECURSIVE chain AS (
+			SELECT id, parent_id, 0 AS depth FROM categories WHERE id = ? AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, c.parent_id, ch.depth + 1
+			FROM categories c
+			JOIN chain ch ON c.id = ch.parent_id
+			WHERE c.deleted_at IS NULL AND ch.depth < 100
+		)
+		SELECT id FROM categories
+		WHERE deleted_at IS NULL AND (id = ? OR id IN (SELECT id FROM chain))
+		ORDER BY id
+		FOR UPDATE`, parentID, id).Scan(&locked).Error
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(locked, parentID) {
+		return repositories.ErrCategoryNotFound
+	}
+
+	// Com as categorias bloqueadas, a cadeia lida de novo reflete as reparentações já concluídas
+	ancestors, err := NewCategoryRepository(tx).GetAncestors(parentID)
+	if err != nil {
+		return err
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.ID == id {
+			return repositories.ErrParentIsDescendant
+		}
+	}
+	return nil
+}
+
 // Delete remove uma categoria
 func (r *categoryRepository) Delete(id uint) error {
 	return r.db.Delete(&models.CategoryModel{}, id).Error
 }
 
+// GetAncestors busca os ancestrais de uma categoria, da raiz até o pai imediato
+func (r *categoryRepository) GetAncestors(id uint) ([]entities.Category, error) {
+	var models []models.CategoryModel
+	err := r.db.Raw(`
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, 0 AS depth FROM categories WHERE id = ? AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, c.parent_id, a.depth + 1
+			FROM categories c
+			JOIN ancestors a ON c.id = a.parent_id
+			WHERE c.deleted_at IS NULL AND a.depth < 100
+		)
+		SELECT categories.* FROM categories
+		JOIN ancestors ON ancestors.id = categories.id
+		WHERE ancestors.depth > 0
+		ORDER BY ancestors.depth DESC`, id).Scan(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]entities.Category, len(models))
+	for i, model := range models {
+		categories[i] = *r.mapToEntity(&model)
+	}
+
+	return categories, nil
+}
+
+// GetDescendantIDs busca o ID da categoria e de todas as suas subcategorias
+func (r *categoryRepository) GetDescendantIDs(id uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.Raw(`
+		WITH RECURSIVE descendants AS (
+			SELECT id, 0 AS depth FROM categories WHERE id = ? AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, d.depth + 1
+			FROM categories c
+			JOIN descendants d ON c.parent_id = d.id
+			WHERE c.deleted_at IS NULL AND d.depth < 100
+		)
+		SELECT id FROM descendants`, id).Scan(&ids).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// DeleteIfUnused remove a categoria somente se nenhum produto ou subcategoria a referenciar.
+// Retorna o uso encontrado; a categoria só é removida quando ambos os contadores são zero.
+func (r *categoryRepository) DeleteIfUnused(id uint, version int64) (*repositories.CategoryUsage, error) {
+	usage := &repositories.CategoryUsage{}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Bloquear a categoria para que nenhum produto ou subcategoria seja ligado a ela durante a
+		// verificação: essas gravações a bloqueiam em modo compartilhado (ver lockCategory)
+		if err := lockVersioned(tx, &models.CategoryModel{}, id, version); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.ProductModel{}).Where("category_id = ?", id).Count(&usage.Products).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.CategoryModel{}).Where("parent_id = ?", id).Count(&usage.Subcategories).Error; err != nil {
+			return err
+		}
+
+		if usage.Products > 0 || usage.Subcategories > 0 {
+			return nil
+		}
+		return tx.Delete(&models.CategoryModel{}, id).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// ReassignAndDelete move produtos e subcategorias para a categoria destino e remove a categoria
+func (r *categoryRepository) ReassignAndDelete(id, targetID uint, version int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockVersioned(tx, &models.CategoryModel{}, id, version); err != nil {
+			return err
+		}
+
+		// Bloquear o destino para que ele não seja removido nem movido antes da realocação
+		var target models.CategoryModel
+		result := tx.Clauses(clause.Locking{Strength: "SHARE"}).Limit(1).Find(&target, targetID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return repositories.ErrTargetNotFound
+		}
+
+		// Subcategorias movidas para um descendente formariam um ciclo
+		descendantIDs, err := NewCategoryRepository(tx).GetDescendantIDs(id)
+		if err != nil {
+			return err
+		}
+		for _, descendantID := range descendantIDs {
+			if descendantID == targetID {
+				return repositories.ErrTargetIsDescendant
+			}
+		}
+
+		err = tx.Model(&models.ProductModel{}).
+			Where("category_id = ?", id).
+			Update("category_id", targetID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&models.CategoryModel{}).
+			Where("parent_id = ?", id).
+			Update("parent_id", targetID).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(&models.CategoryModel{}, id).Error
+	})
+}
+
+// DeleteCascade remove a categoria, suas subcategorias e todos os produtos ligados a elas
+func (r *categoryRepository) DeleteCascade(id uint, version int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockVersioned(tx, &models.CategoryModel{}, id, version); err != nil {
+			return err
+		}
+
+		ids, err := NewCategoryRepository(tx).GetDescendantIDs(id)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := tx.Where("category_id IN ?", ids).Delete(&models.ProductModel{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&models.CategoryModel{}, ids).Error
+	})
+}
+
 // mapToEntity converte modelo para entidade
 func (r *categoryRepository) mapToEntity(model *models.CategoryModel) *entities.Category {
 	return &entities.Category{
 		ID:        model.ID,
 		Name:      model.Name,
+		ParentID:  model.ParentID,
+		Version:   model.Version,
 		CreatedAt: model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
 	}
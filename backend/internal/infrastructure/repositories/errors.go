@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolation é o código do PostgreSQL para violação de unicidade
+const uniqueViolation = "23505"
+
+// constraintFields associa as restrições de unicidade ao campo da API que elas protegem
+var constraintFields = map[string]string{
+	"idx_products_sku":                "sku",
+	"idx_products_slug":               "slug",
+	"idx_product_variants_sku":        "sku",
+	"idx_product_variants_option_key": "options",
+	// Gatilho que mantém o SKU único entre produtos e variantes (ver db.Migrate)
+	"products_variants_sku": "sku",
+}
+
+// translateError converte erros do PostgreSQL em erros do domínio
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return &repositories.DuplicateKeyError{Field: constraintFields[pgErr.ConstraintName]}
+	}
+	return err
+}
+
+// nullableString converte strings vazias em NULL
+func nullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
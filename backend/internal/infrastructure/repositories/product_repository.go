@@ -4,6 +4,11 @@ import (
 	"catalogo-produtos/backend/internal/domain/entities"
 	"catalogo-produtos/backend/internal/domain/repositories"
 	"catalogo-produtos/backend/internal/infrastructure/database/models"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strconv"
 
 	"gorm.io/gorm"
 )
@@ -21,20 +26,31 @@ func NewProductRepository(db *gorm.DB) repositories.ProductRepository {
 // Create cria um novo produto
 func (r *productRepository) Create(product *entities.Product) error {
 	model := &models.ProductModel{
+		SKU:         nullableString(product.SKU),
+		Slug:        product.Slug,
 		Name:        product.Name,
 		Image:       product.Image,
-		Price:       product.Price,
+		Price:       product.Price.String(),
+		Currency:    product.Price.Currency,
 		CategoryID:  product.CategoryID,
 		Description: product.Description,
+		Attributes:  models.JSONMap(product.Attributes),
 	}
 
-	err := r.db.Create(model).Error
+	// A categoria fica bloqueada até o fim da transação, para que não seja removida com o produto já ligado a ela
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCategory(tx, model.CategoryID); err != nil {
+			return err
+		}
+		return translateError(tx.Create(model).Error)
+	})
 	if err != nil {
 		return err
 	}
 
 	// Atualizar o ID do produto criado
 	product.ID = model.ID
+	product.Version = model.Version
 	product.CreatedAt = model.CreatedAt
 	product.UpdatedAt = model.UpdatedAt
 
@@ -44,7 +60,7 @@ func (r *productRepository) Create(product *entities.Product) error {
 // GetByID busca um produto por ID
 func (r *productRepository) GetByID(id uint) (*entities.Product, error) {
 	var model models.ProductModel
-	err := r.db.Preload("Category").First(&model, id).Error
+	err := r.db.Preload("Category").Preload("Options", orderOptions).Preload("Variants", orderVariants).First(&model, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -52,25 +68,93 @@ func (r *productRepository) GetByID(id uint) (*entities.Product, error) {
 	return r.mapToEntity(&model), nil
 }
 
-// GetAll busca todos os produtos com filtros
-func (r *productRepository) GetAll(filters *repositories.ProductFilter) ([]entities.Product, error) {
-	var models []models.ProductModel
-	query := r.db.Preload("Category")
+// GetBySKU busca um produto pelo SKU
+func (r *productRepository) GetBySKU(sku string) (*entities.Product, error) {
+	var model models.ProductModel
+	err := r.db.Preload("Category").Preload("Options", orderOptions).Preload("Variants", orderVariants).Where("sku = ?", sku).First(&model).Error
+	if err != nil {
+		return nil, err
+	}
 
-	// Aplicar filtros
-	if filters != nil {
-		if filters.Name != "" {
-			query = query.Where("name ILIKE ?", "%"+filters.Name+"%")
+	return r.mapToEntity(&model), nil
+}
+
+// GetBySlug busca um produto pelo slug
+func (r *productRepository) GetBySlug(slug string) (*entities.Product, error) {
+	var model models.ProductModel
+	err := r.db.Preload("Category").Preload("Options", orderOptions).Preload("Variants", orderVariants).Where("slug = ?", slug).First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapToEntity(&model), nil
+}
+
+// GetByName busca um produto pelo nome exato
+func (r *productRepository) GetByName(name string) (*entities.Product, error) {
+	var model models.ProductModel
+	err := r.db.Preload("Category").Preload("Options", orderOptions).Preload("Variants", orderVariants).Where("name = ?", name).Order("id").First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapToEntity(&model), nil
+}
+
+// GetAll busca os produtos com filtros, ordenação e paginação
+func (r *productRepository) GetAll(filters *repositories.ProductFilter) ([]entities.Product, int64, error) {
+	if filters == nil {
+		filters = &repositories.ProductFilter{}
+	}
+
+	query := r.applyFilters(r.db.Model(&models.ProductModel{}), filters)
+
+	// Contar o total de registros antes da paginação
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Em buscas textuais, calcular relevância e trechos destacados
+	if filters.Query != "" {
+		query = query.Select(fmt.Sprintf(`products.*,
+			ts_rank(products.search_vector, websearch_to_tsquery('portuguese_unaccent', ?)) AS search_rank,
+			ts_headline('portuguese_unaccent', %s, websearch_to_tsquery('portuguese_unaccent', ?), ?) AS search_name,
+			ts_headline('portuguese_unaccent', %s, websearch_to_tsquery('portuguese_unaccent', ?), ?) AS search_description`,
+			escapeHTML("products.name"), escapeHTML("coalesce(products.description, '')")),
+			filters.Query, filters.Query, headlineOptions, filters.Query, headlineOptions)
+
+		if len(filters.Sort) == 0 {
+			query = query.Order("search_rank DESC")
 		}
-		if filters.Category != "" {
-			query = query.Joins("JOIN categories ON categories.id = products.category_id").
-				Where("categories.name ILIKE ?", "%"+filters.Category+"%")
+	}
+
+	// Aplicar ordenação
+	for _, sort := range filters.Sort {
+		column, ok := productSortColumns[sort.Field]
+		if !ok {
+			continue
+		}
+		if sort.Desc {
+			column += " DESC"
+		}
+		query = query.Order(column)
+	}
+	// Desempate estável para a paginação
+	query = query.Order("products.id")
+
+	// Aplicar paginação
+	if filters.PageSize > 0 {
+		query = query.Limit(filters.PageSize)
+		if filters.Page > 1 {
+			query = query.Offset((filters.Page - 1) * filters.PageSize)
 		}
 	}
 
-	err := query.Find(&models).Error
+	var models []models.ProductModel
+	err := query.Preload("Category").Preload("Options", orderOptions).Preload("Variants", orderVariants).Find(&models).Error
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Converter para entidades
@@ -79,52 +163,363 @@ func (r *productRepository) GetAll(filters *repositories.ProductFilter) ([]entit
 		products[i] = *r.mapToEntity(&model)
 	}
 
+	return products, total, nil
+}
+
+// GetByCategoryIDs busca até limit produtos de cada categoria. Os produtos são numerados por
+// categoria com ROW_NUMBER, o que limita cada categoria sem uma consulta por categoria.
+func (r *productRepository) GetByCategoryIDs(categoryIDs []uint, limit int) ([]entities.Product, error) {
+	if len(categoryIDs) == 0 {
+		return nil, nil
+	}
+
+	ranked := r.db.Model(&models.ProductModel{}).
+		Select("products.*, ROW_NUMBER() OVER (PARTITION BY products.category_id ORDER BY products.id) AS category_position").
+		Where("products.category_id IN ?", categoryIDs)
+
+	var models []models.ProductModel
+	err := r.db.Table("(?) AS products", ranked).
+		Where("category_position <= ?", limit).
+		Order("products.category_id, products.id").
+		Preload("Category").Preload("Options", orderOptions).Preload("Variants", orderVariants).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]entities.Product, len(models))
+	for i, model := range models {
+		products[i] = *r.mapToEntity(&model)
+	}
 	return products, nil
 }
 
-// Update atualiza um produto
-func (r *productRepository) Update(product *entities.Product) error {
+// productStreamRow é um produto lido pelo cursor de exportação, com o nome da categoria
+type productStreamRow struct {
+	models.ProductModel
+	CategoryName string
+}
+
+// streamBatchSize define quantos produtos do cursor são agrupados para carregar as variantes
+const streamBatchSize = 500
+
+// Stream percorre os produtos filtrados lendo as linhas diretamente do cursor do banco,
+// sem carregar o resultado inteiro em memória. Opções e variantes são carregadas em lotes.
+func (r *productRepository) Stream(filters *repositories.ProductFilter, fn func(product *entities.Product) error) error {
+	if filters == nil {
+		filters = &repositories.ProductFilter{}
+	}
+
+	query := r.applyFilters(r.db.Model(&models.ProductModel{}), filters).
+		Select("products.*, export_categories.name AS category_name").
+		Joins("LEFT JOIN categories AS export_categories ON export_categories.id = products.category_id")
+
+	for _, sort := range filters.Sort {
+		column, ok := productSortColumns[sort.Field]
+		if !ok {
+			continue
+		}
+		if sort.Desc {
+			column += " DESC"
+		}
+		query = query.Order(column)
+	}
+	query = query.Order("products.id")
+
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	batch := make([]*entities.Product, 0, streamBatchSize)
+	for rows.Next() {
+		var row productStreamRow
+		if err := r.db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+
+		product := r.mapToEntity(&row.ProductModel)
+		product.Category.ID = row.CategoryID
+		product.Category.Name = row.CategoryName
+		batch = append(batch, product)
+
+		if len(batch) == streamBatchSize {
+			if err := r.emitBatch(batch, fn); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return r.emitBatch(batch, fn)
+}
+
+// emitBatch carrega as opções e variantes de um lote de produtos com uma consulta cada e os repassa a fn
+func (r *productRepository) emitBatch(batch []*entities.Product, fn func(product *entities.Product) error) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, len(batch))
+	byID := make(map[uint]*entities.Product, len(batch))
+	for i, product := range batch {
+		ids[i] = product.ID
+		byID[product.ID] = product
+	}
+
+	var options []models.ProductOptionModel
+	if err := orderOptions(r.db.Where("product_id IN ?", ids)).Find(&options).Error; err != nil {
+		return err
+	}
+	for i := range options {
+		product := byID[options[i].ProductID]
+		product.Options = append(product.Options, mapOptionToEntity(&options[i]))
+	}
+
+	var variants []models.ProductVariantModel
+	if err := orderVariants(r.db.Where("product_id IN ?", ids)).Find(&variants).Error; err != nil {
+		return err
+	}
+	for i := range variants {
+		product := byID[variants[i].ProductID]
+		product.Variants = append(product.Variants, mapVariantToEntity(&variants[i]))
+	}
+
+	for _, product := range batch {
+		if err := fn(product); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// orderOptions ordena os eixos de variação carregados com o produto
+func orderOptions(db *gorm.DB) *gorm.DB {
+	return db.Order("position, id")
+}
+
+// orderVariants ordena as variantes carregadas com o produto
+func orderVariants(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
+
+// headlineOptions configura os trechos destacados retornados pela busca textual
+const headlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2"
+
+// escapeHTML escapa para HTML o texto de uma coluna antes do ts_headline, para que só as marcações
+// do destaque cheguem ao cliente sem escape. As entidades geradas não são indexadas pela busca.
+func escapeHTML(column string) string {
+	return fmt.Sprintf(`replace(replace(replace(replace(replace(%s, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`, column)
+}
+
+// productSortColumns mapeia os campos de ordenação para colunas do banco
+var productSortColumns = map[string]string{
+	"id":         "products.id",
+	"name":       "products.name",
+	"price":      "products.price",
+	"created_at": "products.created_at",
+	"updated_at": "products.updated_at",
+}
+
+// applyFilters aplica os filtros de busca à consulta
+func (r *productRepository) applyFilters(query *gorm.DB, filters *repositories.ProductFilter) *gorm.DB {
+	if filters.Query != "" {
+		query = query.Where("products.search_vector @@ websearch_to_tsquery('portuguese_unaccent', ?)", filters.Query)
+	}
+	if filters.Name != "" {
+		query = query.Where("products.name ILIKE ?", "%"+filters.Name+"%")
+	}
+	if filters.Category != "" {
+		query = query.Joins("JOIN categories ON categories.id = products.category_id").
+			Where("categories.name ILIKE ?", "%"+filters.Category+"%")
+	}
+	if len(filters.CategoryIDs) > 0 {
+		query = query.Where("products.category_id IN ?", filters.CategoryIDs)
+	}
+	if filters.Currency != "" {
+		query = query.Where("products.currency = ?", filters.Currency)
+	}
+	// Valores de moedas diferentes não são comparáveis: o filtro de preço vale só na sua moeda
+	if filters.MinPrice != nil {
+		query = query.Where("products.currency = ? AND products.price >= ?", filters.MinPrice.Currency, filters.MinPrice.String())
+	}
+	if filters.MaxPrice != nil {
+		query = query.Where("products.currency = ? AND products.price <= ?", filters.MaxPrice.Currency, filters.MaxPrice.String())
+	}
+	if filters.CreatedAfter != nil {
+		query = query.Where("products.created_at >= ?", *filters.CreatedAfter)
+	}
+	if filters.UpdatedAfter != nil {
+		query = query.Where("products.updated_at >= ?", *filters.UpdatedAfter)
+	}
+	if filters.HasImage != nil {
+		if *filters.HasImage {
+			query = query.Where("COALESCE(products.image, '') <> ''")
+		} else {
+			query = query.Where("COALESCE(products.image, '') = ''")
+		}
+	}
+	for _, attribute := range filters.Attributes {
+		query = applyAttributeFilter(query, attribute)
+	}
+	return query
+}
+
+// attributeKeyPattern restringe as chaves de atributo interpoladas nas consultas
+var attributeKeyPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// applyAttributeFilter aplica um filtro sobre o valor de um atributo do produto.
+// Comparações de ordem só consideram atributos numéricos; igualdade compara o texto.
+func applyAttributeFilter(query *gorm.DB, filter repositories.AttributeFilter) *gorm.DB {
+	if !attributeKeyPattern.MatchString(filter.Key) {
+		return query.Where("1 = 0")
+	}
+
+	text := fmt.Sprintf("products.attributes->>'%s'", filter.Key)
+	number := fmt.Sprintf("CASE WHEN jsonb_typeof(products.attributes->'%s') = 'number' THEN (%s)::numeric END", filter.Key, text)
+
+	switch filter.Operator {
+	case repositories.AttributeOpEqual:
+		return query.Where(text+" = ?", filter.Value)
+	case repositories.AttributeOpNotEqual:
+		return query.Where("("+text+" IS NULL OR "+text+" <> ?)", filter.Value)
+	case repositories.AttributeOpGreater, repositories.AttributeOpGreaterEqual,
+		repositories.AttributeOpLess, repositories.AttributeOpLessEqual:
+		value, err := strconv.ParseFloat(filter.Value, 64)
+		if err != nil {
+			return query.Where("1 = 0")
+		}
+		return query.Where(number+" "+filter.Operator+" ?", value)
+	default:
+		return query.Where("1 = 0")
+	}
+}
+
+// productColumns mapeia os campos editáveis do produto para as colunas persistidas
+var productColumns = map[string][]string{
+	"sku":         {"sku"},
+	"slug":        {"slug"},
+	"name":        {"name"},
+	"image":       {"image"},
+	"price":       {"price", "currency"},
+	"currency":    {"price", "currency"},
+	"category_id": {"category_id"},
+	"description": {"description"},
+	"attributes":  {"attributes"},
+}
+
+// productFields lista todos os campos editáveis do produto
+var productFields = []string{"sku", "slug", "name", "image", "price", "category_id", "description", "attributes"}
+
+// Update atualiza os campos informados de um produto
+func (r *productRepository) Update(product *entities.Product, fields ...string) error {
+	if len(fields) == 0 {
+		fields = productFields
+	}
+
 	model := &models.ProductModel{
 		ID:          product.ID,
+		SKU:         nullableString(product.SKU),
+		Slug:        product.Slug,
 		Name:        product.Name,
 		Image:       product.Image,
-		Price:       product.Price,
+		Price:       product.Price.String(),
+		Currency:    product.Price.Currency,
 		CategoryID:  product.CategoryID,
 		Description: product.Description,
+		Attributes:  models.JSONMap(product.Attributes),
 	}
 
-	err := r.db.Save(model).Error
+	// Com a versão lida, a atualização só é aplicada se ninguém alterou o produto nesse meio tempo
+	columns := columnsFor(fields, productColumns)
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if slices.Contains(columns, "category_id") {
+			if err := lockCategory(tx, model.CategoryID); err != nil {
+				return err
+			}
+		}
+		if product.Version > 0 {
+			return updateVersioned(tx, model, product.ID, product.Version, columns)
+		}
+		return updateColumns(tx, model, columns)
+	})
 	if err != nil {
 		return err
 	}
 
-	// Atualizar timestamps
+	// Atualizar versão e timestamps
+	product.Version = model.Version
 	product.UpdatedAt = model.UpdatedAt
 
 	return nil
 }
 
 // Delete remove um produto
-func (r *productRepository) Delete(id uint) error {
-	return r.db.Delete(&models.ProductModel{}, id).Error
+func (r *productRepository) Delete(id uint, version int64) error {
+	return deleteVersioned(r.db, &models.ProductModel{}, id, version)
 }
 
 // mapToEntity converte modelo para entidade
 func (r *productRepository) mapToEntity(model *models.ProductModel) *entities.Product {
-	return &entities.Product{
-		ID:          model.ID,
-		Name:        model.Name,
-		Image:       model.Image,
-		Price:       model.Price,
-		CategoryID:  model.CategoryID,
-		Description: model.Description,
-		CreatedAt:   model.CreatedAt,
-		UpdatedAt:   model.UpdatedAt,
+	product := &entities.Product{
+		ID:            model.ID,
+		Slug:          model.Slug,
+		Name:          model.Name,
+		Image:         model.Image,
+		Price:         r.mapToMoney(model),
+		CategoryID:    model.CategoryID,
+		Description:   model.Description,
+		StockQuantity: model.StockQuantity,
+		Attributes:    map[string]interface{}(model.Attributes),
+		Version:       model.Version,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
 		Category: entities.Category{
 			ID:        model.Category.ID,
 			Name:      model.Category.Name,
+			Version:   model.Category.Version,
 			CreatedAt: model.Category.CreatedAt,
 			UpdatedAt: model.Category.UpdatedAt,
 		},
+		SearchRank: model.SearchRank,
+	}
+	if model.SKU != nil {
+		product.SKU = *model.SKU
+	}
+	if product.Attributes == nil {
+		product.Attributes = map[string]interface{}{}
+	}
+
+	product.Options = make([]entities.ProductOption, len(model.Options))
+	for i := range model.Options {
+		product.Options[i] = mapOptionToEntity(&model.Options[i])
+	}
+	product.Variants = make([]entities.ProductVariant, len(model.Variants))
+	for i := range model.Variants {
+		product.Variants[i] = mapVariantToEntity(&model.Variants[i])
+	}
+
+	if model.SearchName != "" || model.SearchDescription != "" {
+		product.Highlight = &entities.SearchHighlight{
+			Name:        model.SearchName,
+			Description: model.SearchDescription,
+		}
+	}
+
+	return product
+}
+
+// mapToMoney converte o preço decimal do banco para o valor monetário do domínio
+func (r *productRepository) mapToMoney(model *models.ProductModel) entities.Money {
+	// A coluna decimal(10,2) guarda duas casas mesmo em moedas sem centavos (ex: JPY 1000.00)
+	price, err := entities.ParseDecimalMoney(model.Price, model.Currency)
+	if err != nil {
+		// Só falha se a moeda for desconhecida
+		log.Printf("Preço inválido no produto %d: %v", model.ID, err)
 	}
+	return price
 }
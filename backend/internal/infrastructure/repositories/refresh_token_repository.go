@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"catalogo-produtos/backend/internal/infrastructure/database/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// refreshTokenRepository implementa RefreshTokenRepository
+type refreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository cria uma nova instância de RefreshTokenRepository
+func NewRefreshTokenRepository(db *gorm.DB) repositories.RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+// Create registra um refresh token
+func (r *refreshTokenRepository) Create(token *entities.RefreshToken) error {
+	model := r.mapToModel(token)
+	if err := r.db.Create(model).Error; err != nil {
+		return translateError(err)
+	}
+
+	token.ID = model.ID
+	token.CreatedAt = model.CreatedAt
+	return nil
+}
+
+// GetByHash busca um refresh token pelo hash
+func (r *refreshTokenRepository) GetByHash(hash string) (*entities.RefreshToken, error) {
+	var model models.RefreshTokenModel
+	if err := r.db.Where("token_hash = ?", hash).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return r.mapToEntity(&model), nil
+}
+
+// Rotate revoga o token atual e registra o próximo na mesma transação
+func (r *refreshTokenRepository) Rotate(currentID uint, next *entities.RefreshToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// O UPDATE condicional garante que apenas uma renovação concorrente use o token
+		result := tx.Model(&models.RefreshTokenModel{}).
+			Where("id = ? AND revoked_at IS NULL", currentID).
+			Update("revoked_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return repositories.ErrVersionConflict
+		}
+
+		model := r.mapToModel(next)
+		if err := tx.Create(model).Error; err != nil {
+			return translateError(err)
+		}
+		if err := tx.Model(&models.RefreshTokenModel{}).Where("id = ?", currentID).
+			Update("replaced_by_id", model.ID).Error; err != nil {
+			return err
+		}
+
+		next.ID = model.ID
+		next.CreatedAt = model.CreatedAt
+		return nil
+	})
+}
+
+// RevokeFamily revoga os tokens ainda válidos da família
+func (r *refreshTokenRepository) RevokeFamily(familyID string) error {
+	return r.db.Model(&models.RefreshTokenModel{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+}
+
+// RevokeSubject revoga os tokens ainda válidos do subject
+func (r *refreshTokenRepository) RevokeSubject(subject string) error {
+	return r.db.Model(&models.RefreshTokenModel{}).
+		Where("subject = ? AND revoked_at IS NULL", subject).
+		Update("revoked_at", time.Now()).Error
+}
+
+// mapToModel converte entidade para modelo
+func (r *refreshTokenRepository) mapToModel(token *entities.RefreshToken) *models.RefreshTokenModel {
+	return &models.RefreshTokenModel{
+		FamilyID:     token.FamilyID,
+		Subject:      token.Subject,
+		TokenHash:    token.TokenHash,
+		Claims:       models.JSONMap(token.Claims),
+		ExpiresAt:    token.ExpiresAt,
+		RevokedAt:    token.RevokedAt,
+		ReplacedByID: token.ReplacedByID,
+	}
+}
+
+// mapToEntity converte modelo para entidade
+func (r *refreshTokenRepository) mapToEntity(model *models.RefreshTokenModel) *entities.RefreshToken {
+	return &entities.RefreshToken{
+		ID:           model.ID,
+		FamilyID:     model.FamilyID,
+		Subject:      model.Subject,
+		TokenHash:    model.TokenHash,
+		Claims:       model.Claims,
+		ExpiresAt:    model.ExpiresAt,
+		RevokedAt:    model.RevokedAt,
+		ReplacedByID: model.ReplacedByID,
+		CreatedAt:    model.CreatedAt,
+	}
+}
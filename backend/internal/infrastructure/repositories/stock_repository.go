@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"catalogo-produtos/backend/internal/infrastructure/database/models"
+
+	"gorm.io/gorm"
+)
+
+// stockRepository implementa StockRepository
+type stockRepository struct {
+	db *gorm.DB
+}
+
+// NewStockRepository cria uma nova instância de StockRepository
+func NewStockRepository(db *gorm.DB) repositories.StockRepository {
+	return &stockRepository{db: db}
+}
+
+// RecordMovement aplica a movimentação e registra o lançamento na mesma transação
+func (r *stockRepository) RecordMovement(movement *entities.StockMovement) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// O UPDATE condicional bloqueia a linha do produto, serializando movimentações
+		// concorrentes, e só é aplicado se o saldo resultante não for negativo
+		var balance []int64
+		var result *gorm.DB
+		if movement.VariantID != nil {
+			result = tx.Raw(`
+				UPDATE product_variants
+				SET stock_quantity = stock_quantity + ?
+				WHERE id = ? AND product_id = ? AND deleted_at IS NULL AND stock_quantity + ? >= 0
+				RETURNING stock_quantity`,
+				movement.Quantity, *movement.VariantID, movement.ProductID, movement.Quantity).Scan(&balance)
+		} else {
+			result = tx.Raw(`
+				UPDATE products
+				SET stock_quantity = stock_quantity + ?
+				WHERE id = ? AND deleted_at IS NULL AND stock_quantity + ? >= 0
+				RETURNING stock_quantity`,
+				movement.Quantity, movement.ProductID, movement.Quantity).Scan(&balance)
+		}
+		if result.Error != nil {
+			return result.Error
+		}
+		if len(balance) == 0 {
+			return repositories.ErrInsufficientStock
+		}
+
+		model := &models.StockMovementModel{
+			ProductID:    movement.ProductID,
+			VariantID:    movement.VariantID,
+			Type:         string(movement.Type),
+			Quantity:     movement.Quantity,
+			BalanceAfter: balance[0],
+			Note:         movement.Note,
+		}
+		if err := tx.Create(model).Error; err != nil {
+			return err
+		}
+
+		movement.ID = model.ID
+		movement.BalanceAfter = model.BalanceAfter
+		movement.CreatedAt = model.CreatedAt
+
+		return nil
+	})
+}
+
+// GetMovements busca o histórico de movimentações de um produto e suas variantes, do mais recente ao mais antigo
+func (r *stockRepository) GetMovements(productID uint, page, pageSize int) ([]entities.StockMovement, int64, error) {
+	query := r.db.Model(&models.StockMovementModel{}).Where("product_id = ?", productID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []models.StockMovementModel
+	err := query.Order("id DESC").Limit(pageSize).Offset((page - 1) * pageSize).Find(&models).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	movements := make([]entities.StockMovement, len(models))
+	for i, model := range models {
+		movements[i] = r.mapToEntity(&model)
+	}
+
+	return movements, total, nil
+}
+
+// mapToEntity converte modelo para entidade
+func (r *stockRepository) mapToEntity(model *models.StockMovementModel) entities.StockMovement {
+	return entities.StockMovement{
+		ID:           model.ID,
+		ProductID:    model.ProductID,
+		VariantID:    model.VariantID,
+		Type:         entities.StockMovementType(model.Type),
+		Quantity:     model.Quantity,
+		BalanceAfter: model.BalanceAfter,
+		Note:         model.Note,
+		CreatedAt:    model.CreatedAt,
+	}
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"catalogo-produtos/backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+// transactor implementa Transactor
+type transactor struct {
+	db *gorm.DB
+}
+
+// NewTransactor cria uma nova instância de Transactor
+func NewTransactor(db *gorm.DB) repositories.Transactor {
+	return &transactor{db: db}
+}
+
+// WithinTransaction executa fn com repositórios ligados a uma transação do banco
+func (t *transactor) WithinTransaction(fn func(repos repositories.Repositories) error) error {
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		return fn(repositories.Repositories{
+			Products:   NewProductRepository(tx),
+			Categories: NewCategoryRepository(tx),
+			Attributes: NewAttributeRepository(tx),
+			Transactor: NewTransactor(tx),
+		})
+	})
+}
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"catalogo-produtos/backend/internal/infrastructure/database/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// updateColumns atualiza apenas as colunas informadas (e updated_at), sem sobrescrever
+// created_at. Retorna gorm.ErrRecordNotFound se o registro não existir mais.
+func updateColumns(db *gorm.DB, model interface{}, columns []string) error {
+	result := db.Model(model).Select(columns).Updates(model)
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// updateVersioned atualiza as colunas apenas se o registro ainda estiver na versão informada,
+// lendo de volta a nova versão gerada pelo banco. Retorna repositories.ErrVersionConflict se
+// outra operação alterou o registro antes, ou gorm.ErrRecordNotFound se ele não existir mais.
+func updateVersioned(db *gorm.DB, model interface{}, id uint, version int64, columns []string) error {
+	result := db.Model(model).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "version"}}}).
+		Where("version = ?", version).
+		Select(columns).
+		Updates(model)
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := db.Model(model).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return repositories.ErrVersionConflict
+}
+
+// deleteVersioned remove o registro apenas se ele ainda estiver na versão informada. Retorna
+// repositories.ErrVersionConflict se outra operação alterou ou removeu o registro antes.
+func deleteVersioned(db *gorm.DB, model interface{}, id uint, version int64) error {
+	result := db.Where("version = ?", version).Delete(model, id)
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrVersionConflict
+	}
+	return nil
+}
+
+// lockVersioned bloqueia o registro até o fim da transação, desde que ele ainda esteja na versão
+// informada. Retorna repositories.ErrVersionConflict se outra operação alterou ou removeu o registro.
+func lockVersioned(tx *gorm.DB, model interface{}, id uint, version int64) error {
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("version = ?", version).
+		Limit(1).
+		Find(model, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrVersionConflict
+	}
+	return nil
+}
+
+// columnsFor converte os campos da API nas colunas correspondentes, sem repetições
+func columnsFor(fields []string, mapping map[string][]string) []string {
+	seen := make(map[string]bool)
+	var columns []string
+	for _, field := range fields {
+		for _, column := range mapping[field] {
+			if !seen[column] {
+				seen[column] = true
+				columns = append(columns, column)
+			}
+		}
+	}
+	return columns
+}
+
+// lockCategory bloqueia a categoria em modo compartilhado até o fim da transação, impedindo que ela
+// seja removida enquanto um produto é ligado a ela. Retorna repositories.ErrCategoryNotFound se a
+// categoria não existir ou já tiver sido removida.
+func lockCategory(tx *gorm.DB, id uint) error {
+	result := tx.Clauses(clause.Locking{Strength: "SHARE"}).Limit(1).Find(&models.CategoryModel{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrCategoryNotFound
+	}
+	return nil
+}
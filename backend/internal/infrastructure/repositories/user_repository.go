@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"catalogo-produtos/backend/internal/infrastructure/database/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// userRepository implementa UserRepository
+type userRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository cria uma nova instância de UserRepository
+func NewUserRepository(db *gorm.DB) repositories.UserRepository {
+	return &userRepository{db: db}
+}
+
+// Create cria um novo usuário
+func (r *userRepository) Create(user *entities.User) error {
+	model := r.mapToModel(user)
+	if err := r.db.Create(model).Error; err != nil {
+		return translateError(err)
+	}
+
+	user.ID = model.ID
+	user.CreatedAt = model.CreatedAt
+	user.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+// GetByID busca um usuário por ID
+func (r *userRepository) GetByID(id uint) (*entities.User, error) {
+	var model models.UserModel
+	if err := r.db.First(&model, id).Error; err != nil {
+		return nil, err
+	}
+	return r.mapToEntity(&model), nil
+}
+
+// GetByEmail busca um usuário pelo e-mail
+func (r *userRepository) GetByEmail(email string) (*entities.User, error) {
+	var model models.UserModel
+	if err := r.db.Where("email = ?", email).First(&model).Error; err != nil {
+		return nil, err
+	}
+	return r.mapToEntity(&model), nil
+}
+
+// GetAll busca todos os usuários, ordenados pelo e-mail
+func (r *userRepository) GetAll() ([]entities.User, error) {
+	var userModels []models.UserModel
+	if err := r.db.Order("email").Find(&userModels).Error; err != nil {
+		return nil, err
+	}
+
+	users := make([]entities.User, len(userModels))
+	for i, model := range userModels {
+		users[i] = *r.mapToEntity(&model)
+	}
+	return users, nil
+}
+
+// userColumns mapeia os campos editáveis do usuário para as colunas persistidas
+var userColumns = map[string][]string{
+	"name":          {"name"},
+	"roles":         {"roles"},
+	"password":      {"password_hash"},
+	"disabled":      {"disabled"},
+	"last_login_at": {"last_login_at"},
+}
+
+// Update atualiza os campos informados de um usuário
+func (r *userRepository) Update(user *entities.User, fields ...string) error {
+	if len(fields) == 0 {
+		fields = []string{"name", "roles", "password", "disabled", "last_login_at"}
+	}
+
+	model := r.mapToModel(user)
+	model.ID = user.ID
+	columns := columnsFor(fields, userColumns)
+	if !changesAdmins(columns) {
+		if err := updateColumns(r.db, model, columns); err != nil {
+			return err
+		}
+		user.UpdatedAt = model.UpdatedAt
+		return nil
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Bloquear os administradores ativos serializa as alterações concorrentes de papéis e de
+		// status, para que duas delas não removam juntas os dois últimos administradores
+		var admins []uint
+		err := tx.Model(&models.UserModel{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("roles @> ? AND NOT disabled", `["admin"]`).
+			Order("id").
+			Pluck("id", &admins).Error
+		if err != nil {
+			return err
+		}
+		if len(admins) == 1 && admins[0] == user.ID && !user.IsActiveAdmin() {
+			return repositories.ErrLastAdmin
+		}
+		return updateColumns(tx, model, columns)
+	})
+	if err != nil {
+		return err
+	}
+
+	user.UpdatedAt = model.UpdatedAt
+	return nil
+}
+
+// changesAdmins indica se a atualização pode alterar quem são os administradores ativos
+func changesAdmins(columns []string) bool {
+	for _, column := range columns {
+		if column == "roles" || column == "disabled" {
+			return true
+		}
+	}
+	return false
+}
+
+// mapToModel converte entidade para modelo
+func (r *userRepository) mapToModel(user *entities.User) *models.UserModel {
+	roles := make(models.StringList, len(user.Roles))
+	for i, role := range user.Roles {
+		roles[i] = string(role)
+	}
+	return &models.UserModel{
+		Email:        user.Email,
+		Name:         user.Name,
+		PasswordHash: user.PasswordHash,
+		Roles:        roles,
+		Disabled:     user.Disabled,
+		LastLoginAt:  user.LastLoginAt,
+	}
+}
+
+// mapToEntity converte modelo para entidade
+func (r *userRepository) mapToEntity(model *models.UserModel) *entities.User {
+	roles := make([]entities.Role, len(model.Roles))
+	for i, role := range model.Roles {
+		roles[i] = entities.Role(role)
+	}
+	return &entities.User{
+		ID:           model.ID,
+		Email:        model.Email,
+		Name:         model.Name,
+		PasswordHash: model.PasswordHash,
+		Roles:        roles,
+		Disabled:     model.Disabled,
+		LastLoginAt:  model.LastLoginAt,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}
+}
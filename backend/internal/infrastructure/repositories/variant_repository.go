@@ -0,0 +1,181 @@
+package repositories
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"catalogo-produtos/backend/internal/infrastructure/database/models"
+	"log"
+
+	"gorm.io/gorm"
+)
+
+// variantRepository implementa VariantRepository
+type variantRepository struct {
+	db *gorm.DB
+}
+
+// NewVariantRepository cria uma nova instância de VariantRepository
+func NewVariantRepository(db *gorm.DB) repositories.VariantRepository {
+	return &variantRepository{db: db}
+}
+
+// ReplaceOptions substitui todos os eixos de variação de um produto
+func (r *variantRepository) ReplaceOptions(productID uint, options []entities.ProductOption) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("product_id = ?", productID).Delete(&models.ProductOptionModel{}).Error; err != nil {
+			return err
+		}
+
+		for i := range options {
+			model := &models.ProductOptionModel{
+				ProductID: productID,
+				Name:      options[i].Name,
+				Position:  i,
+				Values:    models.StringList(options[i].Values),
+			}
+			if err := tx.Create(model).Error; err != nil {
+				return err
+			}
+
+			options[i].ID = model.ID
+			options[i].ProductID = productID
+			options[i].Position = i
+		}
+
+		return nil
+	})
+}
+
+// Create cria uma nova variante
+func (r *variantRepository) Create(variant *entities.ProductVariant) error {
+	model := r.mapToModel(variant)
+
+	err := r.db.Create(model).Error
+	if err != nil {
+		return translateError(err)
+	}
+
+	// Atualizar o ID da variante criada
+	variant.ID = model.ID
+	variant.CreatedAt = model.CreatedAt
+	variant.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+// GetByID busca uma variante por ID
+func (r *variantRepository) GetByID(id uint) (*entities.ProductVariant, error) {
+	var model models.ProductVariantModel
+	err := r.db.First(&model, id).Error
+	if err != nil {
+		return nil, err
+	}
+
+	variant := mapVariantToEntity(&model)
+	return &variant, nil
+}
+
+// GetBySKU busca uma variante pelo SKU
+func (r *variantRepository) GetBySKU(sku string) (*entities.ProductVariant, error) {
+	var model models.ProductVariantModel
+	err := r.db.Where("sku = ?", sku).First(&model).Error
+	if err != nil {
+		return nil, err
+	}
+
+	variant := mapVariantToEntity(&model)
+	return &variant, nil
+}
+
+// GetByProductID busca as variantes de um produto
+func (r *variantRepository) GetByProductID(productID uint) ([]entities.ProductVariant, error) {
+	var models []models.ProductVariantModel
+	err := r.db.Where("product_id = ?", productID).Order("id").Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	variants := make([]entities.ProductVariant, len(models))
+	for i, model := range models {
+		variants[i] = mapVariantToEntity(&model)
+	}
+
+	return variants, nil
+}
+
+// Update atualiza uma variante
+func (r *variantRepository) Update(variant *entities.ProductVariant) error {
+	model := r.mapToModel(variant)
+
+	err := updateColumns(r.db, model, []string{"sku", "options", "option_key", "price", "currency", "image"})
+	if err != nil {
+		return err
+	}
+
+	// Atualizar timestamps
+	variant.UpdatedAt = model.UpdatedAt
+
+	return nil
+}
+
+// Delete remove uma variante
+func (r *variantRepository) Delete(id uint) error {
+	return r.db.Delete(&models.ProductVariantModel{}, id).Error
+}
+
+// mapToModel converte entidade para modelo
+func (r *variantRepository) mapToModel(variant *entities.ProductVariant) *models.ProductVariantModel {
+	model := &models.ProductVariantModel{
+		ID:        variant.ID,
+		ProductID: variant.ProductID,
+		SKU:       variant.SKU,
+		Options:   models.StringMap(variant.Options),
+		OptionKey: variant.OptionKey(),
+		Currency:  entities.DefaultCurrency,
+		Image:     variant.Image,
+	}
+
+	if variant.Price != nil {
+		price := variant.Price.String()
+		model.Price = &price
+		model.Currency = variant.Price.Currency
+	}
+
+	return model
+}
+
+// mapOptionToEntity converte o modelo de opção para entidade
+func mapOptionToEntity(model *models.ProductOptionModel) entities.ProductOption {
+	return entities.ProductOption{
+		ID:        model.ID,
+		ProductID: model.ProductID,
+		Name:      model.Name,
+		Position:  model.Position,
+		Values:    []string(model.Values),
+	}
+}
+
+// mapVariantToEntity converte o modelo de variante para entidade
+func mapVariantToEntity(model *models.ProductVariantModel) entities.ProductVariant {
+	variant := entities.ProductVariant{
+		ID:            model.ID,
+		ProductID:     model.ProductID,
+		SKU:           model.SKU,
+		Options:       map[string]string(model.Options),
+		Image:         model.Image,
+		StockQuantity: model.StockQuantity,
+		CreatedAt:     model.CreatedAt,
+		UpdatedAt:     model.UpdatedAt,
+	}
+
+	if model.Price != nil {
+		price, err := entities.ParseDecimalMoney(*model.Price, model.Currency)
+		if err != nil {
+			// A coluna decimal(10,2) guarda duas casas em qualquer moeda; só falha se a moeda for desconhecida
+			log.Printf("Preço inválido na variante %d: %v", model.ID, err)
+		}
+		variant.Price = &price
+	}
+
+	return variant
+}
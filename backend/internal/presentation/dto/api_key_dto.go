@@ -0,0 +1,49 @@
+package dto
+
+import "time"
+
+// APIKeyCreateRequest representa os dados de uma nova chave de API
+type APIKeyCreateRequest struct {
+	Name string `json:"name" binding:"required,max=255" example:"Sincronização ERP"`
+	// Scopes são os escopos da chave (products:read, products:write, categories:read,
+	// categories:write, stock:write, imports:run)
+	Scopes []string `json:"scopes" binding:"required,min=1" example:"products:write"`
+	// ExpiresAt é o fim da validade da chave; ausente cria uma chave sem expiração
+	ExpiresAt *time.Time `json:"expires_at" example:"2027-01-01T00:00:00Z"`
+}
+
+// APIKeyResponse representa uma chave de API; a chave em si nunca é retornada nas consultas
+type APIKeyResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name" example:"Sincronização ERP"`
+	// Prefix é o início da chave, para identificá-la
+	Prefix     string     `json:"prefix" example:"cpk_Xb3k9QzA"`
+	Scopes     []string   `json:"scopes" example:"products:write"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	// Active indica se a chave pode ser usada: não foi revogada nem expirou
+	Active    bool      `json:"active"`
+	CreatedBy string    `json:"created_by" example:"user:1"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// APIKeySecretResponse representa uma chave criada ou rotacionada, com a chave em si,
+// exibida apenas nessa resposta
+type APIKeySecretResponse struct {
+	Data APIKeyResponse `json:"data"`
+	// Key é a chave a enviar no cabeçalho X-API-Key
+	Key string `json:"key" example:"cpk_Xb3k9QzA..."`
+}
+
+// SingleAPIKeyResponse representa a resposta de uma chave de API única
+type SingleAPIKeyResponse struct {
+	Data APIKeyResponse `json:"data"`
+}
+
+// APIKeysResponse representa a lista de chaves de API
+type APIKeysResponse struct {
+	Data []APIKeyResponse `json:"data"`
+	Meta ListMetaV2       `json:"meta"`
+}
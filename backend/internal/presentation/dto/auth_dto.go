@@ -0,0 +1,17 @@
+package dto
+
+// RefreshTokenRequest representa o refresh token enviado para renovação ou revogação
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// TokenResponse representa os tokens emitidos (formato do OAuth 2.0, RFC 6749)
+type TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type" example:"Bearer"`
+	// ExpiresIn é a validade do token de acesso, em segundos
+	ExpiresIn    int64  `json:"expires_in" example:"900"`
+	RefreshToken string `json:"refresh_token"`
+	// RefreshExpiresIn é a validade do refresh token, em segundos
+	RefreshExpiresIn int64 `json:"refresh_expires_in" example:"2592000"`
+}
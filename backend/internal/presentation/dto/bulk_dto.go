@@ -0,0 +1,39 @@
+package dto
+
+import "encoding/json"
+
+// BulkOperationRequest representa uma operação de um lote de produtos
+type BulkOperationRequest struct {
+	Action string `json:"action" binding:"required,oneof=create update delete" example:"update"`
+	ID     uint   `json:"id" binding:"required_unless=Action create" example:"1"`
+	// Version é obrigatória em update e delete: sem ela a operação falha com 428, e com 412 quando o produto mudou
+	Version int64 `json:"version" binding:"omitempty,min=1" example:"3"`
+	// Data contém o produto (create) ou um JSON Merge Patch com os campos a alterar (update)
+	Data json.RawMessage `json:"data" swaggertype:"object"`
+}
+
+// BulkRequest representa um lote de operações de produtos
+type BulkRequest struct {
+	Operations []BulkOperationRequest `json:"operations" binding:"required,min=1,max=500,dive"`
+}
+
+// BulkResultResponse representa o resultado de uma operação do lote
+type BulkResultResponse struct {
+	Index  int    `json:"index"`
+	Action string `json:"action"`
+	ID     uint   `json:"id,omitempty"`
+	// Status é o código HTTP equivalente ao da operação individual
+	Status int `json:"status" example:"200"`
+	// Error descreve a falha da operação no mesmo formato das respostas de erro (RFC 7807)
+	Error   *ProblemResponse `json:"error,omitempty"`
+	Changed []string         `json:"changed,omitempty"`
+	Data    *ProductResponse `json:"data,omitempty"`
+}
+
+// BulkResponse representa a resposta de um lote de operações
+type BulkResponse struct {
+	Atomic    bool                 `json:"atomic"`
+	Succeeded int                  `json:"succeeded"`
+	Failed    int                  `json:"failed"`
+	Results   []BulkResultResponse `json:"results"`
+}
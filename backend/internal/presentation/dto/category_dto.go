@@ -2,12 +2,28 @@ package dto
 
 // CategoryCreateRequest representa os dados para criar uma categoria
 type CategoryCreateRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name     string `json:"name" binding:"required"`
+	ParentID *uint  `json:"parent_id"`
 }
 
 // CategoryUpdateRequest representa os dados para atualizar uma categoria
 type CategoryUpdateRequest struct {
-	Name string `json:"name" binding:"required"`
+	Name     string `json:"name" binding:"required"`
+	ParentID *uint  `json:"parent_id"`
+}
+
+// CategoryPatchRequest documenta o corpo de um JSON Merge Patch de categoria;
+// parent_id null torna a categoria raiz
+type CategoryPatchRequest struct {
+	Name     *string `json:"name,omitempty"`
+	ParentID *uint   `json:"parent_id,omitempty"`
+}
+
+// PatchCategoryResponse representa a resposta de uma atualização parcial de categoria
+type PatchCategoryResponse struct {
+	Data CategoryResponse `json:"data"`
+	// Changed lista os campos efetivamente alterados
+	Changed []string `json:"changed" example:"name"`
 }
 
 // CategoriesResponse representa a resposta de uma lista de categorias
@@ -20,3 +36,61 @@ type CategoriesResponse struct {
 type SingleCategoryResponse struct {
 	Data CategoryResponse `json:"data"`
 }
+
+// CategoryBreadcrumb representa um nível do caminho de uma categoria
+type CategoryBreadcrumb struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+// CategoryTreeNode representa uma categoria na árvore de categorias
+type CategoryTreeNode struct {
+	ID       uint               `json:"id"`
+	Name     string             `json:"name"`
+	ParentID *uint              `json:"parent_id"`
+	Children []CategoryTreeNode `json:"children"`
+}
+
+// CategoryTreeResponse representa a resposta da árvore de categorias
+type CategoryTreeResponse struct {
+	Data []CategoryTreeNode `json:"data"`
+}
+
+// CategoryDeleteRequest representa as opções de remoção de uma categoria
+type CategoryDeleteRequest struct {
+	Mode   string `form:"mode" binding:"omitempty,oneof=restrict reassign cascade"`
+	Target uint   `form:"target" binding:"required_if=Mode reassign"`
+}
+
+// CategoryAttributeRequest representa a definição de um atributo de categoria
+type CategoryAttributeRequest struct {
+	Key      string   `json:"key" binding:"required" example:"ram_gb"`
+	Label    string   `json:"label" example:"Memória RAM"`
+	Type     string   `json:"type" binding:"required,oneof=string number enum boolean" example:"number"`
+	Unit     string   `json:"unit" example:"GB"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options"`
+}
+
+// CategoryAttributesRequest representa os atributos definidos pela própria categoria
+type CategoryAttributesRequest struct {
+	Attributes []CategoryAttributeRequest `json:"attributes" binding:"dive"`
+}
+
+// CategoryAttributeResponse representa um atributo do schema efetivo de uma categoria
+type CategoryAttributeResponse struct {
+	Key        string   `json:"key"`
+	Label      string   `json:"label"`
+	Type       string   `json:"type"`
+	Unit       string   `json:"unit"`
+	Required   bool     `json:"required"`
+	Options    []string `json:"options"`
+	CategoryID uint     `json:"category_id"`
+	// Inherited indica que o atributo foi definido por uma categoria ancestral
+	Inherited bool `json:"inherited"`
+}
+
+// CategoryAttributesResponse representa o schema efetivo de uma categoria
+type CategoryAttributesResponse struct {
+	Data []CategoryAttributeResponse `json:"data"`
+}
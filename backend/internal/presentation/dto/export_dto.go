@@ -0,0 +1,19 @@
+package dto
+
+// ProductExportRow representa um produto exportado (uma linha do CSV ou do NDJSON)
+type ProductExportRow struct {
+	ID            uint                   `json:"id"`
+	SKU           string                 `json:"sku"`
+	Slug          string                 `json:"slug"`
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	Image         string                 `json:"image"`
+	Price         string                 `json:"price" example:"2999.99"`
+	Currency      string                 `json:"currency" example:"BRL"`
+	CategoryID    uint                   `json:"category_id"`
+	Category      string                 `json:"category" example:"Eletrônicos"`
+	StockQuantity int64                  `json:"stock_quantity"`
+	Attributes    map[string]interface{} `json:"attributes"`
+	CreatedAt     string                 `json:"created_at"`
+	UpdatedAt     string                 `json:"updated_at"`
+}
@@ -0,0 +1,16 @@
+package dto
+
+// FeedItem representa um produto no feed do Google Merchant (RSS 2.0 com namespace g:)
+type FeedItem struct {
+	ID               string `xml:"g:id"`
+	Title            string `xml:"title"`
+	Description      string `xml:"description"`
+	Link             string `xml:"link"`
+	ImageLink        string `xml:"g:image_link,omitempty"`
+	Price            string `xml:"g:price"`
+	Availability     string `xml:"g:availability"`
+	ProductType      string `xml:"g:product_type,omitempty"`
+	Condition        string `xml:"g:condition"`
+	IdentifierExists string `xml:"g:identifier_exists"`
+	ItemGroupID      string `xml:"g:item_group_id,omitempty"`
+}
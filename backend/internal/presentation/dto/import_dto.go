@@ -0,0 +1,23 @@
+package dto
+
+// ImportRowResponse representa o resultado da importação de uma linha da planilha
+type ImportRowResponse struct {
+	Line      int      `json:"line" example:"2"`
+	Status    string   `json:"status" example:"created"`
+	ProductID uint     `json:"product_id,omitempty"`
+	SKU       string   `json:"sku,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Changed   []string `json:"changed,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// ImportReportResponse representa o relatório de uma importação de produtos
+type ImportReportResponse struct {
+	DryRun            bool                `json:"dry_run"`
+	Total             int                 `json:"total"`
+	Created           int                 `json:"created"`
+	Updated           int                 `json:"updated"`
+	Invalid           int                 `json:"invalid"`
+	CategoriesCreated []string            `json:"categories_created"`
+	Rows              []ImportRowResponse `json:"rows"`
+}
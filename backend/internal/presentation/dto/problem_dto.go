@@ -0,0 +1,53 @@
+package dto
+
+import "encoding/json"
+
+// ProblemResponse representa um erro no formato RFC 7807 (application/problem+json)
+type ProblemResponse struct {
+	Type     string `json:"type" example:"urn:catalogo-produtos:problem:product_not_found"`
+	Title    string `json:"title" example:"Not Found"`
+	Status   int    `json:"status" example:"404"`
+	Detail   string `json:"detail" example:"produto não encontrado"`
+	Instance string `json:"instance,omitempty" example:"/api/products/42"`
+	// Code é o código estável do erro, para tratamento pelos clientes
+	Code string `json:"code" example:"product_not_found"`
+	// Errors detalha os campos inválidos ou em conflito
+	Errors []ProblemField `json:"errors,omitempty"`
+	// Extensions são membros adicionais específicos do erro, serializados no nível raiz
+	// (ex: products e subcategories em category_in_use)
+	Extensions map[string]interface{} `json:"-"`
+}
+
+// ProblemField detalha um campo de um problema
+type ProblemField struct {
+	Field   string `json:"field" example:"price"`
+	Message string `json:"message" example:"preço deve ser maior que zero"`
+}
+
+// MarshalJSON serializa o problema com as extensões no nível raiz, como prevê a RFC 7807
+func (p ProblemResponse) MarshalJSON() ([]byte, error) {
+	type problem ProblemResponse
+	data, err := json.Marshal(problem(p))
+	if err != nil || len(p.Extensions) == 0 {
+		return data, err
+	}
+
+	members := make(map[string]interface{}, len(p.Extensions))
+	for key, value := range p.Extensions {
+		members[key] = value
+	}
+	var base map[string]json.RawMessage
+	if err := json.Unmarshal(data, &base); err != nil {
+		return nil, err
+	}
+	// Os membros padrão prevalecem sobre extensões de mesmo nome
+	for key, value := range base {
+		members[key] = value
+	}
+	return json.Marshal(members)
+}
+
+// ErrorResponse representa um erro no formato original da v1
+type ErrorResponse struct {
+	Error string `json:"error" example:"Produto não encontrado"`
+}
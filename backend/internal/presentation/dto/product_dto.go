@@ -1,54 +1,126 @@
 package dto
 
+import "encoding/json"
+
 // ProductCreateRequest representa os dados para criar um produto
 type ProductCreateRequest struct {
-	Name        string  `json:"name" binding:"required"`
-	Image       string  `json:"image"`
-	Price       float64 `json:"price" binding:"required,gt=0"`
-	CategoryID  uint    `json:"category_id" binding:"required"`
-	Description string  `json:"description"`
+	// SKU é opcional na v1, como no contrato original: omitido, é gerado (ex: PRD-3F9A2C41)
+	SKU         string                 `json:"sku" binding:"omitempty,max=64" example:"CAM-BAS-001"`
+	Slug        string                 `json:"slug" binding:"omitempty,max=255"`
+	Name        string                 `json:"name" binding:"required"`
+	Image       string                 `json:"image"`
+	Price       json.Number            `json:"price" binding:"required" swaggertype:"string" example:"2999.99"`
+	Currency    string                 `json:"currency" binding:"omitempty,currency" example:"BRL"`
+	CategoryID  uint                   `json:"category_id" binding:"required"`
+	Description string                 `json:"description"`
+	Attributes  map[string]interface{} `json:"attributes" example:"ram_gb:8"`
 }
 
 // ProductUpdateRequest representa os dados para atualizar um produto
 type ProductUpdateRequest struct {
-	Name        string  `json:"name" binding:"required"`
-	Image       string  `json:"image"`
-	Price       float64 `json:"price" binding:"required,gt=0"`
-	CategoryID  uint    `json:"category_id" binding:"required"`
-	Description string  `json:"description"`
+	// SKU é opcional na v1, como no contrato original: omitido, o SKU atual é mantido
+	SKU         string                 `json:"sku" binding:"omitempty,max=64" example:"CAM-BAS-001"`
+	Slug        string                 `json:"slug" binding:"omitempty,max=255"`
+	Name        string                 `json:"name" binding:"required"`
+	Image       string                 `json:"image"`
+	Price       json.Number            `json:"price" binding:"required" swaggertype:"string" example:"2999.99"`
+	Currency    string                 `json:"currency" binding:"omitempty,currency" example:"BRL"`
+	CategoryID  uint                   `json:"category_id" binding:"required"`
+	Description string                 `json:"description"`
+	Attributes  map[string]interface{} `json:"attributes" example:"ram_gb:8"`
+}
+
+// ProductPatchRequest documenta o corpo de um JSON Merge Patch de produto: apenas os
+// campos enviados são alterados e null remove o valor (sku, image, description, slug, atributos)
+type ProductPatchRequest struct {
+	SKU         *string                `json:"sku,omitempty" example:"CAM-BAS-001"`
+	Slug        *string                `json:"slug,omitempty"`
+	Name        *string                `json:"name,omitempty"`
+	Image       *string                `json:"image,omitempty"`
+	Price       *string                `json:"price,omitempty" example:"2799.99"`
+	Currency    *string                `json:"currency,omitempty" example:"BRL"`
+	CategoryID  *uint                  `json:"category_id,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
 }
 
 // ProductFilterRequest representa os filtros para busca de produtos
 type ProductFilterRequest struct {
-	Name     string `form:"name"`
-	Category string `form:"category"`
+	Q                    string `form:"q"`
+	Name                 string `form:"name"`
+	Category             string `form:"category"`
+	CategoryIDs          []uint `form:"category_id" binding:"omitempty,dive,gt=0"`
+	IncludeSubcategories bool   `form:"include_subcategories"`
+	Currency             string `form:"currency" binding:"omitempty,currency"`
+	MinPrice             string `form:"min_price"`
+	MaxPrice             string `form:"max_price"`
+	CreatedAfter         string `form:"created_after"`
+	UpdatedAfter         string `form:"updated_after"`
+	HasImage             *bool  `form:"has_image"`
+	Page                 int    `form:"page" binding:"omitempty,min=1"`
+	PageSize             int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Sort                 string `form:"sort"`
 }
 
 // ProductResponse representa a resposta de um produto
 type ProductResponse struct {
-	ID          uint             `json:"id"`
-	Name        string           `json:"name"`
-	Image       string           `json:"image"`
-	Price       float64          `json:"price"`
-	CategoryID  uint             `json:"category_id"`
-	Category    CategoryResponse `json:"category"`
-	Description string           `json:"description"`
-	CreatedAt   string           `json:"created_at"`
-	UpdatedAt   string           `json:"updated_at"`
+	ID    uint   `json:"id"`
+	SKU   string `json:"sku"`
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+	Image string `json:"image"`
+	// Price é numérico na v1; o valor é o decimal exato, sem passar por float
+	Price             json.Number             `json:"price" swaggertype:"number" example:"2999.99"`
+	Currency          string                  `json:"currency" example:"BRL"`
+	CategoryID        uint                    `json:"category_id"`
+	Category          CategoryResponse        `json:"category"`
+	Description       string                  `json:"description"`
+	Attributes        map[string]interface{}  `json:"attributes"`
+	InStock           bool                    `json:"in_stock"`
+	AvailableQuantity int64                   `json:"available_quantity"`
+	Options           []ProductOptionResponse `json:"options"`
+	Variants          []VariantResponse       `json:"variants"`
+	// Version acompanha a ETag do produto e é incrementada a cada alteração
+	Version   int64              `json:"version" example:"3"`
+	CreatedAt string             `json:"created_at"`
+	UpdatedAt string             `json:"updated_at"`
+	Highlight *HighlightResponse `json:"highlight,omitempty"`
+}
+
+// HighlightResponse representa os trechos destacados de um resultado de busca
+type HighlightResponse struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Rank        float64 `json:"rank"`
 }
 
 // CategoryResponse representa a resposta de uma categoria
 type CategoryResponse struct {
-	ID        uint   `json:"id"`
-	Name      string `json:"name"`
+	ID          uint                 `json:"id"`
+	Name        string               `json:"name"`
+	ParentID    *uint                `json:"parent_id"`
+	Breadcrumbs []CategoryBreadcrumb `json:"breadcrumbs,omitempty"`
+	// Version acompanha a ETag da categoria e é incrementada a cada alteração
+	Version   int64  `json:"version" example:"1"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
 }
 
 // ProductsResponse representa a resposta de uma lista de produtos
 type ProductsResponse struct {
-	Data  []ProductResponse `json:"data"`
-	Total int               `json:"total"`
+	Data       []ProductResponse `json:"data"`
+	Total      int64             `json:"total"`
+	Page       int               `json:"page"`
+	PageSize   int               `json:"page_size"`
+	TotalPages int               `json:"total_pages"`
+	Links      PaginationLinks   `json:"links"`
+}
+
+// PaginationLinks representa os links de navegação entre páginas
+type PaginationLinks struct {
+	Self string `json:"self"`
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
 }
 
 // ProductResponse representa a resposta de um produto único
@@ -56,12 +128,14 @@ type SingleProductResponse struct {
 	Data ProductResponse `json:"data"`
 }
 
+// PatchProductResponse representa a resposta de uma atualização parcial de produto
+type PatchProductResponse struct {
+	Data ProductResponse `json:"data"`
+	// Changed lista os campos efetivamente alterados
+	Changed []string `json:"changed" example:"price"`
+}
+
 // MessageResponse representa uma resposta de mensagem
 type MessageResponse struct {
 	Message string `json:"message"`
 }
-
-// ErrorResponse representa uma resposta de erro
-type ErrorResponse struct {
-	Error string `json:"error"`
-}
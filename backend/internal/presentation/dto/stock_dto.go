@@ -0,0 +1,40 @@
+package dto
+
+// StockMovementRequest representa os dados para registrar uma movimentação de estoque
+type StockMovementRequest struct {
+	VariantID *uint  `json:"variant_id"`
+	Type      string `json:"type" binding:"required,oneof=receipt sale adjustment return" example:"receipt"`
+	Quantity  int64  `json:"quantity" binding:"required" example:"10"`
+	Note      string `json:"note" binding:"max=500"`
+}
+
+// StockMovementsRequest representa os parâmetros de paginação do histórico de estoque
+type StockMovementsRequest struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// StockMovementResponse representa a resposta de uma movimentação de estoque
+type StockMovementResponse struct {
+	ID           uint   `json:"id"`
+	ProductID    uint   `json:"product_id"`
+	VariantID    *uint  `json:"variant_id"`
+	Type         string `json:"type"`
+	Quantity     int64  `json:"quantity"`
+	BalanceAfter int64  `json:"balance_after"`
+	Note         string `json:"note"`
+	CreatedAt    string `json:"created_at"`
+}
+
+// SingleStockMovementResponse representa a resposta de uma movimentação única
+type SingleStockMovementResponse struct {
+	Data StockMovementResponse `json:"data"`
+}
+
+// StockMovementsResponse representa a resposta do histórico de movimentações
+type StockMovementsResponse struct {
+	Data     []StockMovementResponse `json:"data"`
+	Total    int64                   `json:"total"`
+	Page     int                     `json:"page"`
+	PageSize int                     `json:"page_size"`
+}
@@ -0,0 +1,65 @@
+package dto
+
+import "time"
+
+// RegisterRequest representa os dados de uma nova conta
+type RegisterRequest struct {
+	Email    string `json:"email" binding:"required,max=255" example:"maria@empresa.com"`
+	Name     string `json:"name" binding:"required,max=255" example:"Maria Souza"`
+	Password string `json:"password" binding:"required"`
+}
+
+// LoginRequest representa as credenciais de login
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required" example:"maria@empresa.com"`
+	Password string `json:"password" binding:"required"`
+}
+
+// ChangePasswordRequest representa a troca de senha do usuário autenticado
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required"`
+}
+
+// UserCreateRequest representa os dados para um administrador criar um usuário
+type UserCreateRequest struct {
+	Email    string `json:"email" binding:"required,max=255" example:"joao@empresa.com"`
+	Name     string `json:"name" binding:"required,max=255" example:"João Lima"`
+	Password string `json:"password" binding:"required"`
+	// Roles são os papéis do usuário (viewer, editor, admin); vazio concede apenas viewer
+	Roles []string `json:"roles" example:"editor"`
+}
+
+// UserPatchRequest representa uma atualização parcial de um usuário; campos ausentes são mantidos
+type UserPatchRequest struct {
+	Name  *string   `json:"name" binding:"omitempty,max=255"`
+	Roles *[]string `json:"roles" example:"editor"`
+}
+
+// SetPasswordRequest representa a redefinição de senha por um administrador
+type SetPasswordRequest struct {
+	Password string `json:"password" binding:"required"`
+}
+
+// UserResponse representa um usuário; o hash da senha nunca é retornado
+type UserResponse struct {
+	ID          uint       `json:"id"`
+	Email       string     `json:"email" example:"maria@empresa.com"`
+	Name        string     `json:"name" example:"Maria Souza"`
+	Roles       []string   `json:"roles" example:"viewer"`
+	Disabled    bool       `json:"disabled"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// SingleUserResponse representa a resposta de um usuário único
+type SingleUserResponse struct {
+	Data UserResponse `json:"data"`
+}
+
+// UsersResponse representa a lista de usuários
+type UsersResponse struct {
+	Data []UserResponse `json:"data"`
+	Meta ListMetaV2     `json:"meta"`
+}
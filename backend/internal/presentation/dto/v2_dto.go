@@ -0,0 +1,113 @@
+package dto
+
+// DTOs da API v2. Em relação à v1, valores monetários são objetos com o valor decimal
+// sempre em texto, e as listagens trazem a paginação em meta.
+
+// MoneyV2 representa um valor monetário: amount é o decimal exato em texto
+type MoneyV2 struct {
+	Amount   string `json:"amount" example:"2999.99"`
+	Currency string `json:"currency" example:"BRL"`
+}
+
+// MoneyV2Request representa um valor monetário enviado pelo cliente; números em amount são rejeitados
+type MoneyV2Request struct {
+	Amount   string `json:"amount" binding:"required" example:"2999.99"`
+	Currency string `json:"currency" binding:"omitempty,currency" example:"BRL"`
+}
+
+// PageMetaV2 representa os dados de paginação de uma listagem
+type PageMetaV2 struct {
+	Total      int64 `json:"total"`
+	Page       int   `json:"page"`
+	PageSize   int   `json:"page_size"`
+	TotalPages int   `json:"total_pages"`
+}
+
+// ProductV2Request representa os dados para criar ou substituir um produto
+type ProductV2Request struct {
+	SKU         string                 `json:"sku" binding:"required,max=64" example:"CAM-BAS-001"`
+	Slug        string                 `json:"slug" binding:"omitempty,max=255"`
+	Name        string                 `json:"name" binding:"required"`
+	Image       string                 `json:"image"`
+	Price       MoneyV2Request         `json:"price"`
+	CategoryID  uint                   `json:"category_id" binding:"required"`
+	Description string                 `json:"description"`
+	Attributes  map[string]interface{} `json:"attributes" example:"ram_gb:8"`
+}
+
+// ProductV2PatchRequest documenta o corpo de um JSON Merge Patch de produto na v2: price é
+// um objeto e aceita apenas amount, apenas currency ou ambos
+type ProductV2PatchRequest struct {
+	SKU         *string                `json:"sku,omitempty" example:"CAM-BAS-001"`
+	Slug        *string                `json:"slug,omitempty"`
+	Name        *string                `json:"name,omitempty"`
+	Image       *string                `json:"image,omitempty"`
+	Price       *MoneyV2               `json:"price,omitempty"`
+	CategoryID  *uint                  `json:"category_id,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Attributes  map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// ProductV2Response representa a resposta de um produto
+type ProductV2Response struct {
+	ID                uint                    `json:"id"`
+	SKU               string                  `json:"sku"`
+	Slug              string                  `json:"slug"`
+	Name              string                  `json:"name"`
+	Image             string                  `json:"image"`
+	Price             MoneyV2                 `json:"price"`
+	Category          CategoryBreadcrumb      `json:"category"`
+	Description       string                  `json:"description"`
+	Attributes        map[string]interface{}  `json:"attributes"`
+	InStock           bool                    `json:"in_stock"`
+	AvailableQuantity int64                   `json:"available_quantity"`
+	Options           []ProductOptionResponse `json:"options"`
+	Variants          []VariantV2Response     `json:"variants"`
+	Version           int64                   `json:"version" example:"3"`
+	CreatedAt         string                  `json:"created_at"`
+	UpdatedAt         string                  `json:"updated_at"`
+	Highlight         *HighlightResponse      `json:"highlight,omitempty"`
+}
+
+// VariantV2Response representa uma variante dentro de um produto
+type VariantV2Response struct {
+	ID      uint              `json:"id"`
+	SKU     string            `json:"sku"`
+	Options map[string]string `json:"options"`
+	// Price é o preço efetivo da variante: o próprio, se houver, ou o do produto
+	Price             MoneyV2 `json:"price"`
+	PriceOverride     bool    `json:"price_override"`
+	Image             string  `json:"image"`
+	InStock           bool    `json:"in_stock"`
+	AvailableQuantity int64   `json:"available_quantity"`
+}
+
+// ProductsV2Response representa uma página de produtos
+type ProductsV2Response struct {
+	Data  []ProductV2Response `json:"data"`
+	Meta  PageMetaV2          `json:"meta"`
+	Links PaginationLinks     `json:"links"`
+}
+
+// SingleProductV2Response representa a resposta de um produto único
+type SingleProductV2Response struct {
+	Data ProductV2Response `json:"data"`
+}
+
+// PatchProductV2Response representa a resposta de uma atualização parcial de produto
+type PatchProductV2Response struct {
+	Data ProductV2Response `json:"data"`
+	// Changed lista os campos efetivamente alterados
+	Changed []string `json:"changed" example:"price"`
+}
+
+// ListMetaV2 representa os dados de uma listagem sem paginação
+type ListMetaV2 struct {
+	Total int `json:"total"`
+}
+
+// CategoriesV2Response representa a lista de categorias
+type CategoriesV2Response struct {
+	Data []CategoryResponse `json:"data"`
+	Meta ListMetaV2         `json:"meta"`
+}
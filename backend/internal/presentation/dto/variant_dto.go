@@ -0,0 +1,65 @@
+package dto
+
+import "encoding/json"
+
+// ProductOptionRequest representa um eixo de variação de um produto
+type ProductOptionRequest struct {
+	Name   string   `json:"name" binding:"required,max=100" example:"Tamanho"`
+	Values []string `json:"values" binding:"required,min=1,dive,required" example:"P,M,G"`
+}
+
+// ProductOptionsRequest representa os dados para definir os eixos de variação de um produto
+type ProductOptionsRequest struct {
+	Options []ProductOptionRequest `json:"options" binding:"dive"`
+}
+
+// ProductOptionResponse representa a resposta de um eixo de variação
+type ProductOptionResponse struct {
+	ID       uint     `json:"id"`
+	Name     string   `json:"name"`
+	Position int      `json:"position"`
+	Values   []string `json:"values"`
+}
+
+// ProductOptionsResponse representa a resposta dos eixos de variação de um produto
+type ProductOptionsResponse struct {
+	Data []ProductOptionResponse `json:"data"`
+}
+
+// VariantRequest representa os dados para criar ou atualizar uma variante
+type VariantRequest struct {
+	SKU     string            `json:"sku" binding:"required,max=64" example:"CAM-BAS-P-AZUL"`
+	Options map[string]string `json:"options" binding:"required"`
+	// Price sobrescreve o preço do produto; omitido para usar o preço do produto
+	Price *json.Number `json:"price" swaggertype:"string" example:"54.99"`
+	Image string       `json:"image"`
+}
+
+// VariantResponse representa a resposta de uma variante
+type VariantResponse struct {
+	ID        uint              `json:"id"`
+	ProductID uint              `json:"product_id"`
+	SKU       string            `json:"sku"`
+	Options   map[string]string `json:"options"`
+	// Price é o preço efetivo da variante: o próprio, se houver, ou o do produto
+	Price             json.Number `json:"price" swaggertype:"number" example:"54.99"`
+	Currency          string      `json:"currency" example:"BRL"`
+	PriceOverride     bool        `json:"price_override"`
+	Image             string      `json:"image"`
+	InStock           bool        `json:"in_stock"`
+	AvailableQuantity int64       `json:"available_quantity"`
+	CreatedAt         string      `json:"created_at"`
+	UpdatedAt         string      `json:"updated_at"`
+}
+
+// VariantsResponse representa a resposta das opções e variantes de um produto
+type VariantsResponse struct {
+	Options []ProductOptionResponse `json:"options"`
+	Data    []VariantResponse       `json:"data"`
+	Total   int                     `json:"total"`
+}
+
+// SingleVariantResponse representa a resposta de uma variante única
+type SingleVariantResponse struct {
+	Data VariantResponse `json:"data"`
+}
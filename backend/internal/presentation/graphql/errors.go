@@ -0,0 +1,67 @@
+package graphql
+
+import (
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"catalogo-produtos/backend/internal/presentation/middleware"
+	"log"
+	"net/http"
+	"strconv"
+
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// resolverError expõe um erro ao cliente GraphQL com o mesmo código e status das respostas
+// REST em extensions (ex: {"code": "sku_in_use", "status": 409})
+type resolverError struct {
+	problem dto.ProblemResponse
+}
+
+func (e *resolverError) Error() string {
+	return e.problem.Detail
+}
+
+// Extensions é incluído pelo graphql-go no erro da resposta
+func (e *resolverError) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{
+		"code":   e.problem.Code,
+		"status": e.problem.Status,
+	}
+	if len(e.problem.Errors) > 0 {
+		extensions["fields"] = e.problem.Errors
+	}
+	for key, value := range e.problem.Extensions {
+		if _, ok := extensions[key]; !ok {
+			extensions[key] = value
+		}
+	}
+	return extensions
+}
+
+// resolverErr converte um erro dos casos de uso em erro GraphQL; erros internos não expõem detalhes
+func resolverErr(err error) error {
+	problem := middleware.ProblemFor(err)
+	if problem.Status == http.StatusInternalServerError {
+		log.Printf("Erro interno no GraphQL: %v", err)
+	}
+	return &resolverError{problem: problem}
+}
+
+// parseID converte um argumento ID em um ID numérico
+func parseID(id gql.ID, field string) (uint, error) {
+	value, err := strconv.ParseUint(string(id), 10, 32)
+	if err != nil || value == 0 {
+		return 0, resolverErr(usecases.NewValidationError("invalid_id", "ID inválido: "+field,
+			usecases.FieldError{Field: field, Message: "deve ser um ID numérico"}))
+	}
+	return uint(value), nil
+}
+
+// parseVersion valida a versão esperada do registro, obrigatória nas mutações de escrita
+func parseVersion(version int32) (int64, error) {
+	if version < 1 {
+		return 0, resolverErr(usecases.NewValidationError("invalid_version", "versão inválida",
+			usecases.FieldError{Field: "version", Message: "deve ser a versão obtida na leitura"}))
+	}
+	return int64(version), nil
+}
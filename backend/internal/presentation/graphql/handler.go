@@ -0,0 +1,63 @@
+package graphql
+
+import (
+	"catalogo-produtos/backend/internal/domain/usecases"
+	_ "embed"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// maxDepth limita o aninhamento das consultas (ex: categoria → produtos → categoria → ...)
+const maxDepth = 8
+
+// errMalformedRequest indica um corpo que não é uma requisição GraphQL
+var errMalformedRequest = usecases.NewBadRequestError("malformed_request", "Envie um JSON com query e, opcionalmente, operationName e variables")
+
+// Handler executa consultas GraphQL sobre os casos de uso de produtos e categorias
+type Handler struct {
+	schema          *gql.Schema
+	productUseCase  usecases.ProductUseCase
+	categoryUseCase usecases.CategoryUseCase
+}
+
+// NewHandler cria uma nova instância de Handler
+func NewHandler(productUseCase usecases.ProductUseCase, categoryUseCase usecases.CategoryUseCase) *Handler {
+	resolver := &Resolver{
+		productUseCase:  productUseCase,
+		categoryUseCase: categoryUseCase,
+	}
+
+	return &Handler{
+		schema:          gql.MustParseSchema(schemaSDL, resolver, gql.MaxDepth(maxDepth)),
+		productUseCase:  productUseCase,
+		categoryUseCase: categoryUseCase,
+	}
+}
+
+// request representa o corpo de uma requisição GraphQL
+type request struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Serve executa uma consulta GraphQL. Erros de execução vão em errors, com status 200,
+// como prevê a especificação; apenas um corpo inválido responde 400.
+func (h *Handler) Serve(c *gin.Context) {
+	var req request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errMalformedRequest)
+		return
+	}
+
+	// Cada requisição tem seus próprios lotes, descartados ao final
+	ctx := withLoaders(c.Request.Context(), newLoaders(h.productUseCase, h.categoryUseCase))
+	response := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+	c.JSON(http.StatusOK, response)
+}
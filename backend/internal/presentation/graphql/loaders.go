@@ -0,0 +1,336 @@
+package graphql
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"context"
+	"sync"
+)
+
+// loadersKey é a chave dos loaders no contexto da requisição
+type loadersKey struct{}
+
+// withLoaders adiciona os loaders da requisição ao contexto
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+// loadersFrom obtém os loaders da requisição
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// loaders guarda os dados carregados durante uma requisição. As categorias são poucas e
+// carregadas todas de uma vez, na primeira vez que alguma é necessária; pais e filhos
+// são resolvidos a partir delas sem novas consultas.
+type loaders struct {
+	productUseCase  usecases.ProductUseCase
+	categoryUseCase usecases.CategoryUseCase
+
+	mu         sync.Mutex
+	loaded     bool
+	categories []*entities.Category
+	byID       map[uint]*entities.Category
+	children   map[uint][]*entities.Category
+}
+
+// newLoaders cria os loaders de uma requisição
+func newLoaders(productUseCase usecases.ProductUseCase, categoryUseCase usecases.CategoryUseCase) *loaders {
+	return &loaders{
+		productUseCase:  productUseCase,
+		categoryUseCase: categoryUseCase,
+	}
+}
+
+// loadCategories carrega todas as categorias, se ainda não foram carregadas
+func (l *loaders) loadCategories() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.loaded {
+		return nil
+	}
+
+	categories, err := l.categoryUseCase.GetCategories()
+	if err != nil {
+		return err
+	}
+
+	l.categories = make([]*entities.Category, len(categories))
+	l.byID = make(map[uint]*entities.Category, len(categories))
+	l.children = make(map[uint][]*entities.Category)
+	for i := range categories {
+		category := &categories[i]
+		l.categories[i] = category
+		l.byID[category.ID] = category
+		if category.ParentID != nil {
+			l.children[*category.ParentID] = append(l.children[*category.ParentID], category)
+		}
+	}
+	l.loaded = true
+	return nil
+}
+
+// invalidateCategories descarta as categorias carregadas, após uma mutação que as altere
+func (l *loaders) invalidateCategories() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.loaded = false
+}
+
+// allCategories retorna todas as categorias
+func (l *loaders) allCategories() ([]*entities.Category, error) {
+	if err := l.loadCategories(); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.categories, nil
+}
+
+// category retorna a categoria pelo ID, ou nil se ela não existir
+func (l *loaders) category(id uint) (*entities.Category, error) {
+	if err := l.loadCategories(); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.byID[id], nil
+}
+
+// childrenOf retorna as subcategorias diretas de cada uma das categorias informadas
+func (l *loaders) childrenOf(ids []uint) (map[uint][]*entities.Category, error) {
+	if err := l.loadCategories(); err != nil {
+		return nil, err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	children := make(map[uint][]*entities.Category, len(ids))
+	for _, id := range ids {
+		children[id] = l.children[id]
+	}
+	return children, nil
+}
+
+// categoryBatch agrupa as categorias resolvidas no mesmo nível da consulta: os itens de uma
+// lista ou, um nível abaixo, os filhos (ou pais) de todas as categorias do lote anterior.
+// Cada relação é carregada uma vez para o lote inteiro, de modo que o número de consultas
+// depende da profundidade da consulta, e não da quantidade de categorias.
+type categoryBatch struct {
+	loaders    *loaders
+	categories []*entities.Category
+
+	mu       sync.Mutex
+	products map[int32]*productsLoad
+	children *relationLoad
+	parents  *relationLoad
+}
+
+// productsLoad guarda os produtos carregados para um lote de categorias com um mesmo limite
+type productsLoad struct {
+	once       sync.Once
+	byCategory map[uint][]*productResolver
+	err        error
+}
+
+// relationLoad guarda as categorias relacionadas (pais ou filhos) de um lote de categorias
+type relationLoad struct {
+	once    sync.Once
+	related map[uint][]*categoryResolver
+	err     error
+}
+
+// newCategoryResolvers cria os resolvers de categorias que formam um novo lote
+func newCategoryResolvers(l *loaders, categories []*entities.Category) []*categoryResolver {
+	batch := &categoryBatch{loaders: l, categories: categories}
+	resolvers := make([]*categoryResolver, len(categories))
+	for i, category := range categories {
+		resolvers[i] = &categoryResolver{category: category, batch: batch}
+	}
+	return resolvers
+}
+
+// ids retorna os IDs das categorias do lote
+func (b *categoryBatch) ids() []uint {
+	ids := make([]uint, len(b.categories))
+	for i, category := range b.categories {
+		ids[i] = category.ID
+	}
+	return ids
+}
+
+// productsOf retorna os produtos da categoria, carregando os de todas as categorias do lote
+// com uma única consulta
+func (b *categoryBatch) productsOf(categoryID uint, limit int32) ([]*productResolver, error) {
+	b.mu.Lock()
+	if b.products == nil {
+		b.products = make(map[int32]*productsLoad)
+	}
+	load, ok := b.products[limit]
+	if !ok {
+		load = &productsLoad{}
+		b.products[limit] = load
+	}
+	b.mu.Unlock()
+
+	load.once.Do(func() {
+		byCategory, err := b.loaders.productUseCase.GetProductsByCategories(b.ids(), int(limit))
+		if err != nil {
+			load.err = err
+			return
+		}
+
+		// Os produtos de todas as categorias formam o próximo lote
+		var products []entities.Product
+		for _, category := range b.categories {
+			products = append(products, byCategory[category.ID]...)
+		}
+		resolvers := newProductResolvers(b.loaders, products)
+
+		load.byCategory = make(map[uint][]*productResolver, len(b.categories))
+		for _, resolver := range resolvers {
+			categoryID := resolver.product.CategoryID
+			load.byCategory[categoryID] = append(load.byCategory[categoryID], resolver)
+		}
+	})
+	if load.err != nil {
+		return nil, load.err
+	}
+	return load.byCategory[categoryID], nil
+}
+
+// childrenOf retorna as subcategorias da categoria; os filhos de todo o lote formam um novo lote
+func (b *categoryBatch) childrenOf(categoryID uint) ([]*categoryResolver, error) {
+	b.mu.Lock()
+	if b.children == nil {
+		b.children = &relationLoad{}
+	}
+	load := b.children
+	b.mu.Unlock()
+
+	load.once.Do(func() {
+		children, err := b.loaders.childrenOf(b.ids())
+		if err != nil {
+			load.err = err
+			return
+		}
+		load.related = b.nextBatch(children)
+	})
+	return load.related[categoryID], load.err
+}
+
+// parentOf retorna a categoria pai; os pais de todo o lote formam um novo lote
+func (b *categoryBatch) parentOf(categoryID uint) (*categoryResolver, error) {
+	b.mu.Lock()
+	if b.parents == nil {
+		b.parents = &relationLoad{}
+	}
+	load := b.parents
+	b.mu.Unlock()
+
+	load.once.Do(func() {
+		parents := make(map[uint][]*entities.Category, len(b.categories))
+		for _, category := range b.categories {
+			if category.ParentID == nil {
+				continue
+			}
+			parent, err := b.loaders.category(*category.ParentID)
+			if err != nil {
+				load.err = err
+				return
+			}
+			if parent != nil {
+				parents[category.ID] = []*entities.Category{parent}
+			}
+		}
+		load.related = b.nextBatch(parents)
+	})
+	if load.err != nil || len(load.related[categoryID]) == 0 {
+		return nil, load.err
+	}
+	return load.related[categoryID][0], nil
+}
+
+// nextBatch cria um único lote com as categorias relacionadas a todas as categorias do lote,
+// sem repetir categorias relacionadas a mais de uma
+func (b *categoryBatch) nextBatch(related map[uint][]*entities.Category) map[uint][]*categoryResolver {
+	var categories []*entities.Category
+	seen := make(map[uint]bool)
+	for _, category := range b.categories {
+		for _, other := range related[category.ID] {
+			if !seen[other.ID] {
+				seen[other.ID] = true
+				categories = append(categories, other)
+			}
+		}
+	}
+
+	byID := make(map[uint]*categoryResolver, len(categories))
+	for _, resolver := range newCategoryResolvers(b.loaders, categories) {
+		byID[resolver.category.ID] = resolver
+	}
+
+	result := make(map[uint][]*categoryResolver, len(related))
+	for id, others := range related {
+		for _, other := range others {
+			result[id] = append(result[id], byID[other.ID])
+		}
+	}
+	return result
+}
+
+// productBatch agrupa os produtos resolvidos no mesmo nível da consulta, para que as
+// categorias de todos eles formem um único lote
+type productBatch struct {
+	loaders  *loaders
+	products []*entities.Product
+
+	once       sync.Once
+	categories map[uint]*categoryResolver
+	err        error
+}
+
+// newProductResolvers cria os resolvers de produtos que formam um novo lote
+func newProductResolvers(l *loaders, products []entities.Product) []*productResolver {
+	batch := &productBatch{loaders: l, products: make([]*entities.Product, len(products))}
+	resolvers := make([]*productResolver, len(products))
+	for i := range products {
+		batch.products[i] = &products[i]
+		resolvers[i] = &productResolver{product: &products[i], batch: batch}
+	}
+	return resolvers
+}
+
+// categoryOf retorna a categoria do produto; as categorias de todo o lote formam um novo lote
+func (b *productBatch) categoryOf(product *entities.Product) (*categoryResolver, error) {
+	b.once.Do(func() {
+		var categories []*entities.Category
+		seen := make(map[uint]bool)
+		for _, p := range b.products {
+			if seen[p.CategoryID] {
+				continue
+			}
+			seen[p.CategoryID] = true
+
+			category, err := b.loaders.category(p.CategoryID)
+			if err != nil {
+				b.err = err
+				return
+			}
+			if category == nil {
+				// Categoria criada depois do carregamento: usar a carregada com o produto
+				category = &p.Category
+			}
+			categories = append(categories, category)
+		}
+
+		b.categories = make(map[uint]*categoryResolver, len(categories))
+		for _, resolver := range newCategoryResolvers(b.loaders, categories) {
+			b.categories[resolver.category.ID] = resolver
+		}
+	})
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.categories[product.CategoryID], nil
+}
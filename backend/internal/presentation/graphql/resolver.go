@@ -0,0 +1,420 @@
+package graphql
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// Resolver resolve as consultas e mutações do schema
+type Resolver struct {
+	productUseCase  usecases.ProductUseCase
+	categoryUseCase usecases.CategoryUseCase
+}
+
+// productFilterInput representa o input ProductFilter
+type productFilterInput struct {
+	Q                    *string
+	Name                 *string
+	CategoryIDs          *[]gql.ID
+	IncludeSubcategories *bool
+	Currency             *string
+	MinPrice             *string
+	MaxPrice             *string
+	HasImage             *bool
+}
+
+// productInput representa o input ProductInput
+type productInput struct {
+	Name        string
+	Price       string
+	Currency    *string
+	CategoryID  gql.ID
+	SKU         string
+	Slug        *string
+	Image       *string
+	Description *string
+	Attributes  *jsonObject
+}
+
+// categoryInput representa o input CategoryInput
+type categoryInput struct {
+	Name     string
+	ParentID *gql.ID
+}
+
+// Products retorna uma página de produtos filtrados
+func (r *Resolver) Products(ctx context.Context, args struct {
+	Filter   *productFilterInput
+	Page     int32
+	PageSize int32
+	Sort     *[]string
+}) (*productPageResolver, error) {
+	filters, err := productFilterFrom(args.Filter)
+	if err != nil {
+		return nil, err
+	}
+	filters.Page = int(args.Page)
+	filters.PageSize = int(args.PageSize)
+	if args.Sort != nil {
+		if filters.Sort, err = parseSort(*args.Sort); err != nil {
+			return nil, err
+		}
+	}
+
+	products, total, err := r.productUseCase.GetProducts(filters)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+
+	return &productPageResolver{
+		items:      newProductResolvers(loadersFrom(ctx), products),
+		total:      total,
+		page:       filters.Page,
+		pageSize:   filters.PageSize,
+		totalPages: int((total + int64(filters.PageSize) - 1) / int64(filters.PageSize)),
+	}, nil
+}
+
+// Product retorna um produto pelo ID, SKU ou slug, ou null se ele não existir
+func (r *Resolver) Product(ctx context.Context, args struct {
+	ID   *gql.ID
+	SKU  *string
+	Slug *string
+}) (*productResolver, error) {
+	var product *entities.Product
+	var err error
+	switch {
+	case args.ID != nil && args.SKU == nil && args.Slug == nil:
+		id, idErr := parseID(*args.ID, "id")
+		if idErr != nil {
+			return nil, idErr
+		}
+		product, err = r.productUseCase.GetProduct(id)
+	case args.SKU != nil && args.ID == nil && args.Slug == nil:
+		product, err = r.productUseCase.GetProductBySKU(*args.SKU)
+	case args.Slug != nil && args.ID == nil && args.SKU == nil:
+		product, err = r.productUseCase.GetProductBySlug(*args.Slug)
+	default:
+		return nil, resolverErr(usecases.NewValidationError("invalid_arguments", "informe exatamente um entre id, sku e slug"))
+	}
+
+	if errors.Is(err, usecases.ErrProductNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	return newProductResolvers(loadersFrom(ctx), []entities.Product{*product})[0], nil
+}
+
+// Categories retorna todas as categorias
+func (r *Resolver) Categories(ctx context.Context) ([]*categoryResolver, error) {
+	l := loadersFrom(ctx)
+	categories, err := l.allCategories()
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	return newCategoryResolvers(l, categories), nil
+}
+
+// CategoryTree retorna as categorias raiz
+func (r *Resolver) CategoryTree(ctx context.Context) ([]*categoryResolver, error) {
+	l := loadersFrom(ctx)
+	categories, err := l.allCategories()
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+
+	roots := make([]*entities.Category, 0, len(categories))
+	for _, category := range categories {
+		if category.ParentID == nil {
+			roots = append(roots, category)
+		}
+	}
+	return newCategoryResolvers(l, roots), nil
+}
+
+// Category retorna uma categoria pelo ID, ou null se ela não existir
+func (r *Resolver) Category(ctx context.Context, args struct{ ID gql.ID }) (*categoryResolver, error) {
+	id, err := parseID(args.ID, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	l := loadersFrom(ctx)
+	category, err := l.category(id)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	if category == nil {
+		return nil, nil
+	}
+	return newCategoryResolvers(l, []*entities.Category{category})[0], nil
+}
+
+// CreateProduct cria um produto
+func (r *Resolver) CreateProduct(ctx context.Context, args struct{ Input productInput }) (*productResolver, error) {
+	input, err := productInputFrom(args.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := r.productUseCase.CreateProduct(ctx, *input)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	return newProductResolvers(loadersFrom(ctx), []entities.Product{*product})[0], nil
+}
+
+// UpdateProduct substitui um produto
+func (r *Resolver) UpdateProduct(ctx context.Context, args struct {
+	ID      gql.ID
+	Input   productInput
+	Version int32
+}) (*productResolver, error) {
+	id, err := parseID(args.ID, "id")
+	if err != nil {
+		return nil, err
+	}
+	version, err := parseVersion(args.Version)
+	if err != nil {
+		return nil, err
+	}
+	input, err := productInputFrom(args.Input)
+	if err != nil {
+		return nil, err
+	}
+	input.Version = version
+
+	product, err := r.productUseCase.UpdateProduct(ctx, id, *input)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	return newProductResolvers(loadersFrom(ctx), []entities.Product{*product})[0], nil
+}
+
+// DeleteProduct remove um produto
+func (r *Resolver) DeleteProduct(ctx context.Context, args struct {
+	ID      gql.ID
+	Version int32
+}) (bool, error) {
+	id, err := parseID(args.ID, "id")
+	if err != nil {
+		return false, err
+	}
+	version, err := parseVersion(args.Version)
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.productUseCase.DeleteProduct(ctx, id, version); err != nil {
+		return false, resolverErr(err)
+	}
+	return true, nil
+}
+
+// CreateCategory cria uma categoria
+func (r *Resolver) CreateCategory(ctx context.Context, args struct{ Input categoryInput }) (*categoryResolver, error) {
+	parentID, err := optionalID(args.Input.ParentID, "parentId")
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := r.categoryUseCase.CreateCategory(ctx, args.Input.Name, parentID)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+
+	l := loadersFrom(ctx)
+	l.invalidateCategories()
+	return newCategoryResolvers(l, []*entities.Category{category})[0], nil
+}
+
+// UpdateCategory substitui o nome e o pai de uma categoria
+func (r *Resolver) UpdateCategory(ctx context.Context, args struct {
+	ID      gql.ID
+	Input   categoryInput
+	Version int32
+}) (*categoryResolver, error) {
+	id, err := parseID(args.ID, "id")
+	if err != nil {
+		return nil, err
+	}
+	version, err := parseVersion(args.Version)
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := optionalID(args.Input.ParentID, "parentId")
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := r.categoryUseCase.UpdateCategory(ctx, id, args.Input.Name, parentID, version)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+
+	l := loadersFrom(ctx)
+	l.invalidateCategories()
+	return newCategoryResolvers(l, []*entities.Category{category})[0], nil
+}
+
+// DeleteCategory remove uma categoria
+func (r *Resolver) DeleteCategory(ctx context.Context, args struct {
+	ID       gql.ID
+	Mode     string
+	TargetID *gql.ID
+	Version  int32
+}) (bool, error) {
+	id, err := parseID(args.ID, "id")
+	if err != nil {
+		return false, err
+	}
+	version, err := parseVersion(args.Version)
+	if err != nil {
+		return false, err
+	}
+	targetID, err := optionalID(args.TargetID, "targetId")
+	if err != nil {
+		return false, err
+	}
+
+	options := usecases.DeleteCategoryOptions{
+		Mode:    usecases.DeleteMode(strings.ToLower(args.Mode)),
+		Version: version,
+	}
+	if targetID != nil {
+		options.TargetID = *targetID
+	}
+
+	if err := r.categoryUseCase.DeleteCategory(ctx, id, options); err != nil {
+		return false, resolverErr(err)
+	}
+
+	loadersFrom(ctx).invalidateCategories()
+	return true, nil
+}
+
+// productFilterFrom converte o input ProductFilter nos filtros da listagem
+func productFilterFrom(input *productFilterInput) (*repositories.ProductFilter, error) {
+	filters := &repositories.ProductFilter{}
+	if input == nil {
+		return filters, nil
+	}
+
+	if input.Q != nil {
+		filters.Query = strings.TrimSpace(*input.Q)
+	}
+	if input.Name != nil {
+		filters.Name = *input.Name
+	}
+	if input.CategoryIDs != nil {
+		for _, rawID := range *input.CategoryIDs {
+			id, err := parseID(rawID, "filter.categoryIds")
+			if err != nil {
+				return nil, err
+			}
+			filters.CategoryIDs = append(filters.CategoryIDs, id)
+		}
+	}
+	if input.IncludeSubcategories != nil {
+		filters.IncludeSubcategories = *input.IncludeSubcategories
+	}
+	filters.HasImage = input.HasImage
+
+	filters.Currency = strings.ToUpper(valueOf(input.Currency))
+
+	var err error
+	if filters.MinPrice, err = parsePrice(input.MinPrice, filters.Currency, "filter.minPrice"); err != nil {
+		return nil, err
+	}
+	if filters.MaxPrice, err = parsePrice(input.MaxPrice, filters.Currency, "filter.maxPrice"); err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+// parsePrice converte um filtro de preço decimal não negativo na moeda (padrão BRL)
+func parsePrice(value *string, currency, field string) (*entities.Money, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	price, err := entities.ParseMoney(*value, currency)
+	if err != nil || price.Amount < 0 {
+		message := fmt.Sprintf("%s inválido: informe um valor não negativo com as casas decimais da moeda", field)
+		return nil, resolverErr(usecases.NewValidationError("invalid_parameter", message,
+			usecases.FieldError{Field: field, Message: message}))
+	}
+	return &price, nil
+}
+
+// parseSort converte os campos de ordenação (ex: ["price", "-created_at"])
+func parseSort(values []string) ([]repositories.SortField, error) {
+	fields := make([]repositories.SortField, 0, len(values))
+	for _, value := range values {
+		field := repositories.SortField{Field: strings.TrimPrefix(value, "-"), Desc: strings.HasPrefix(value, "-")}
+		if !repositories.ProductSortFields[field.Field] {
+			message := fmt.Sprintf("campo de ordenação inválido: %s", field.Field)
+			return nil, resolverErr(usecases.NewValidationError("invalid_parameter", message,
+				usecases.FieldError{Field: "sort", Message: message}))
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// productInputFrom converte o input ProductInput nos dados de criação ou substituição de produto
+func productInputFrom(input productInput) (*usecases.ProductInput, error) {
+	categoryID, err := parseID(input.CategoryID, "input.categoryId")
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := entities.ParseMoney(input.Price, valueOf(input.Currency))
+	if err != nil {
+		return nil, resolverErr(usecases.NewValidationError("invalid_price", err.Error(),
+			usecases.FieldError{Field: "input.price", Message: err.Error()}))
+	}
+
+	result := &usecases.ProductInput{
+		Name:        input.Name,
+		Image:       valueOf(input.Image),
+		Description: valueOf(input.Description),
+		Price:       price,
+		CategoryID:  categoryID,
+		SKU:         input.SKU,
+		Slug:        valueOf(input.Slug),
+	}
+	if input.Attributes != nil {
+		result.Attributes = *input.Attributes
+	}
+	return result, nil
+}
+
+// optionalID converte um argumento ID opcional
+func optionalID(id *gql.ID, field string) (*uint, error) {
+	if id == nil {
+		return nil, nil
+	}
+	value, err := parseID(*id, field)
+	if err != nil {
+		return nil, err
+	}
+	return &value, nil
+}
+
+// valueOf retorna o texto informado, ou vazio se ele foi omitido
+func valueOf(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,213 @@
+package graphql
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"errors"
+	"strconv"
+	"time"
+
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// jsonObject implementa o escalar JSON: um objeto JSON livre
+type jsonObject map[string]interface{}
+
+// ImplementsGraphQLType associa o tipo ao escalar JSON do schema
+func (jsonObject) ImplementsGraphQLType(name string) bool {
+	return name == "JSON"
+}
+
+// UnmarshalGraphQL aceita apenas objetos
+func (j *jsonObject) UnmarshalGraphQL(input interface{}) error {
+	object, ok := input.(map[string]interface{})
+	if !ok {
+		return errors.New("JSON deve ser um objeto")
+	}
+	*j = object
+	return nil
+}
+
+// categoryResolver resolve os campos de uma categoria
+type categoryResolver struct {
+	category *entities.Category
+	batch    *categoryBatch
+}
+
+func (r *categoryResolver) ID() gql.ID {
+	return formatID(r.category.ID)
+}
+
+func (r *categoryResolver) Name() string {
+	return r.category.Name
+}
+
+func (r *categoryResolver) Parent() (*categoryResolver, error) {
+	parent, err := r.batch.parentOf(r.category.ID)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	return parent, nil
+}
+
+func (r *categoryResolver) Children() ([]*categoryResolver, error) {
+	children, err := r.batch.childrenOf(r.category.ID)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	if children == nil {
+		children = []*categoryResolver{}
+	}
+	return children, nil
+}
+
+func (r *categoryResolver) Products(args struct{ Limit int32 }) ([]*productResolver, error) {
+	products, err := r.batch.productsOf(r.category.ID, args.Limit)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	if products == nil {
+		products = []*productResolver{}
+	}
+	return products, nil
+}
+
+func (r *categoryResolver) Version() int32 {
+	return int32(r.category.Version)
+}
+
+func (r *categoryResolver) CreatedAt() string {
+	return r.category.CreatedAt.Format(time.RFC3339)
+}
+
+func (r *categoryResolver) UpdatedAt() string {
+	return r.category.UpdatedAt.Format(time.RFC3339)
+}
+
+// productResolver resolve os campos de um produto
+type productResolver struct {
+	product *entities.Product
+	batch   *productBatch
+}
+
+func (r *productResolver) ID() gql.ID {
+	return formatID(r.product.ID)
+}
+
+func (r *productResolver) SKU() string {
+	return r.product.SKU
+}
+
+func (r *productResolver) Slug() string {
+	return r.product.Slug
+}
+
+func (r *productResolver) Name() string {
+	return r.product.Name
+}
+
+func (r *productResolver) Image() string {
+	return r.product.Image
+}
+
+func (r *productResolver) Description() string {
+	return r.product.Description
+}
+
+func (r *productResolver) Price() *moneyResolver {
+	return &moneyResolver{money: r.product.Price}
+}
+
+func (r *productResolver) Category() (*categoryResolver, error) {
+	category, err := r.batch.categoryOf(r.product)
+	if err != nil {
+		return nil, resolverErr(err)
+	}
+	return category, nil
+}
+
+func (r *productResolver) Attributes() jsonObject {
+	if r.product.Attributes == nil {
+		return jsonObject{}
+	}
+	return jsonObject(r.product.Attributes)
+}
+
+func (r *productResolver) InStock() bool {
+	return availableQuantity(r.product) > 0
+}
+
+func (r *productResolver) AvailableQuantity() int32 {
+	return int32(availableQuantity(r.product))
+}
+
+func (r *productResolver) Version() int32 {
+	return int32(r.product.Version)
+}
+
+func (r *productResolver) CreatedAt() string {
+	return r.product.CreatedAt.Format(time.RFC3339)
+}
+
+func (r *productResolver) UpdatedAt() string {
+	return r.product.UpdatedAt.Format(time.RFC3339)
+}
+
+// availableQuantity retorna o estoque disponível: a soma das variantes, se houver, ou o do próprio produto
+func availableQuantity(product *entities.Product) int64 {
+	if len(product.Variants) == 0 {
+		return product.StockQuantity
+	}
+
+	var total int64
+	for _, variant := range product.Variants {
+		total += variant.StockQuantity
+	}
+	return total
+}
+
+// moneyResolver resolve um valor monetário, com o valor decimal exato em texto
+type moneyResolver struct {
+	money entities.Money
+}
+
+func (r *moneyResolver) Amount() string {
+	return r.money.String()
+}
+
+func (r *moneyResolver) Currency() string {
+	return r.money.Currency
+}
+
+// productPageResolver resolve uma página de produtos
+type productPageResolver struct {
+	items      []*productResolver
+	total      int64
+	page       int
+	pageSize   int
+	totalPages int
+}
+
+func (r *productPageResolver) Items() []*productResolver {
+	return r.items
+}
+
+func (r *productPageResolver) Total() int32 {
+	return int32(r.total)
+}
+
+func (r *productPageResolver) Page() int32 {
+	return int32(r.page)
+}
+
+func (r *productPageResolver) PageSize() int32 {
+	return int32(r.pageSize)
+}
+
+func (r *productPageResolver) TotalPages() int32 {
+	return int32(r.totalPages)
+}
+
+// formatID converte um ID numérico para o tipo ID do GraphQL
+func formatID(id uint) gql.ID {
+	return gql.ID(strconv.FormatUint(uint64(id), 10))
+}
@@ -0,0 +1,68 @@
+package grpcserver
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// writeMethodPrefixes identificam os métodos que alteram o catálogo
+var writeMethodPrefixes = []string{"Create", "Update", "Delete"}
+
+// unaryAuthInterceptor exige um token de acesso válido nos metadados authorization
+// ("Bearer <token>"), ou uma chave de API nos metadados x-api-key, dos métodos de escrita e
+// coloca as claims no contexto. Leituras são públicas, como na API HTTP.
+func unaryAuthInterceptor(authUseCase usecases.AuthUseCase, apiKeyUseCase usecases.APIKeyUseCase) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isWriteMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		var claims *entities.TokenClaims
+		var err error
+		if apiKey := firstMetadata(ctx, "x-api-key"); apiKey != "" {
+			claims, err = apiKeyUseCase.Authenticate(apiKey)
+		} else if token := bearerToken(ctx); token != "" {
+			claims, err = authUseCase.Authenticate(token)
+		} else {
+			err = usecases.ErrAuthenticationRequired
+		}
+		if err != nil {
+			return nil, err
+		}
+		return handler(usecases.ContextWithClaims(ctx, claims), req)
+	}
+}
+
+// isWriteMethod indica se o método (ex: /catalog.v1.ProductService/DeleteProduct) altera o catálogo
+func isWriteMethod(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range writeMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// firstMetadata obtém o primeiro valor da chave nos metadados, ou vazio se ausente
+func firstMetadata(ctx context.Context, key string) string {
+	if values := metadata.ValueFromIncomingContext(ctx, key); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+// bearerToken obtém o token dos metadados authorization, ou vazio se ausente
+func bearerToken(ctx context.Context) string {
+	for _, value := range metadata.ValueFromIncomingContext(ctx, "authorization") {
+		if scheme, token, found := strings.Cut(value, " "); found && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,90 @@
+package grpcserver
+
+import (
+	"catalogo-produtos/backend/internal/domain/usecases"
+	catalogv1 "catalogo-produtos/backend/proto/catalog/v1"
+	"context"
+)
+
+// deleteModes associa os modos de remoção do protocolo aos do caso de uso
+var deleteModes = map[catalogv1.CategoryDeleteMode]usecases.DeleteMode{
+	catalogv1.CategoryDeleteMode_CATEGORY_DELETE_MODE_UNSPECIFIED: usecases.DeleteModeRestrict,
+	catalogv1.CategoryDeleteMode_CATEGORY_DELETE_MODE_REASSIGN:    usecases.DeleteModeReassign,
+	catalogv1.CategoryDeleteMode_CATEGORY_DELETE_MODE_CASCADE:     usecases.DeleteModeCascade,
+}
+
+// categoryService implementa catalogv1.CategoryServiceServer sobre CategoryUseCase
+type categoryService struct {
+	catalogv1.UnimplementedCategoryServiceServer
+	categoryUseCase usecases.CategoryUseCase
+}
+
+// GetCategory busca uma categoria pelo ID
+func (s *categoryService) GetCategory(ctx context.Context, req *catalogv1.GetCategoryRequest) (*catalogv1.Category, error) {
+	category, err := s.categoryUseCase.GetCategory(uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return toCategory(category), nil
+}
+
+// ListCategories retorna todas as categorias
+func (s *categoryService) ListCategories(ctx context.Context, req *catalogv1.ListCategoriesRequest) (*catalogv1.ListCategoriesResponse, error) {
+	categories, err := s.categoryUseCase.GetCategories()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &catalogv1.ListCategoriesResponse{
+		Categories: make([]*catalogv1.Category, len(categories)),
+	}
+	for i := range categories {
+		response.Categories[i] = toCategory(&categories[i])
+	}
+	return response, nil
+}
+
+// CreateCategory cria uma categoria
+func (s *categoryService) CreateCategory(ctx context.Context, req *catalogv1.CreateCategoryRequest) (*catalogv1.Category, error) {
+	category, err := s.categoryUseCase.CreateCategory(ctx, req.GetName(), optionalID(req.ParentId))
+	if err != nil {
+		return nil, err
+	}
+	return toCategory(category), nil
+}
+
+// UpdateCategory substitui o nome e o pai de uma categoria
+func (s *categoryService) UpdateCategory(ctx context.Context, req *catalogv1.UpdateCategoryRequest) (*catalogv1.Category, error) {
+	version, err := expectedVersion(req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := s.categoryUseCase.UpdateCategory(ctx, uint(req.GetId()), req.GetName(), optionalID(req.ParentId), version)
+	if err != nil {
+		return nil, err
+	}
+	return toCategory(category), nil
+}
+
+// DeleteCategory remove uma categoria conforme o modo informado
+func (s *categoryService) DeleteCategory(ctx context.Context, req *catalogv1.DeleteCategoryRequest) (*catalogv1.DeleteCategoryResponse, error) {
+	mode, ok := deleteModes[req.GetMode()]
+	if !ok {
+		return nil, invalidArgument("mode", "modo de remoção desconhecido")
+	}
+	version, err := expectedVersion(req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.categoryUseCase.DeleteCategory(ctx, uint(req.GetId()), usecases.DeleteCategoryOptions{
+		Mode:     mode,
+		TargetID: uint(req.GetTargetId()),
+		Version:  version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &catalogv1.DeleteCategoryResponse{}, nil
+}
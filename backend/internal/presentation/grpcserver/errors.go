@@ -0,0 +1,111 @@
+package grpcserver
+
+import (
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain identifica a origem dos erros em ErrorInfo
+const errorDomain = "catalogo-produtos"
+
+// kindCode associa cada tipo de erro de domínio ao código gRPC correspondente. Conflitos
+// dependem do erro e são tratados em codeFor.
+var kindCode = map[usecases.ErrorKind]codes.Code{
+	usecases.KindNotFound:             codes.NotFound,
+	usecases.KindValidation:           codes.InvalidArgument,
+	usecases.KindBadRequest:           codes.InvalidArgument,
+	usecases.KindPreconditionFailed:   codes.Aborted,
+	usecases.KindPreconditionRequired: codes.FailedPrecondition,
+	usecases.KindAborted:              codes.Aborted,
+	usecases.KindUnauthenticated:      codes.Unauthenticated,
+	usecases.KindPermissionDenied:     codes.PermissionDenied,
+}
+
+// codeFor retorna o código gRPC de um erro de domínio. Um conflito com campos é um valor
+// único já em uso (ex: SKU); sem campos, é um estado que impede a operação (ex: categoria em uso).
+func codeFor(err *usecases.Error) codes.Code {
+	if err.Kind == usecases.KindConflict {
+		if len(err.Fields) > 0 {
+			return codes.AlreadyExists
+		}
+		return codes.FailedPrecondition
+	}
+	if code, ok := kindCode[err.Kind]; ok {
+		return code
+	}
+	return codes.Internal
+}
+
+// statusError converte um erro em status gRPC, com o código do erro em ErrorInfo e os campos
+// inválidos em BadRequest. Erros que não são de domínio viram Internal sem expor detalhes internos.
+func statusError(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "requisição cancelada")
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "prazo da requisição esgotado")
+	}
+
+	var domainErr *usecases.Error
+	if !errors.As(err, &domainErr) || codeFor(domainErr) == codes.Internal {
+		log.Printf("Erro interno em %s: %v", method, err)
+		return status.Error(codes.Internal, "Erro interno do servidor")
+	}
+
+	st, detailsErr := status.New(codeFor(domainErr), domainErr.Message).WithDetails(
+		&errdetails.ErrorInfo{Reason: domainErr.Code, Domain: errorDomain, Metadata: metadataFrom(domainErr.Details)},
+	)
+	if detailsErr != nil {
+		return status.Error(codeFor(domainErr), domainErr.Message)
+	}
+	if len(domainErr.Fields) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, field := range domainErr.Fields {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field.Field,
+				Description: field.Message,
+			})
+		}
+		if withFields, err := st.WithDetails(badRequest); err == nil {
+			st = withFields
+		}
+	}
+	return st.Err()
+}
+
+// invalidArgument cria um erro de validação de um campo da requisição
+func invalidArgument(field, message string) error {
+	return usecases.NewValidationError("invalid_argument", message, usecases.FieldError{Field: field, Message: message})
+}
+
+// expectedVersion valida a versão esperada do registro; zero é repassado e recusado pelos casos
+// de uso como versão ausente
+func expectedVersion(version int64) (int64, error) {
+	if version < 0 {
+		return 0, invalidArgument("version", "deve ser a versão obtida na leitura")
+	}
+	return version, nil
+}
+
+// metadataFrom copia os detalhes textuais do erro (ex: missing_permission) para ErrorInfo.Metadata
+func metadataFrom(details map[string]interface{}) map[string]string {
+	metadata := map[string]string{}
+	for key, value := range details {
+		if text, ok := value.(string); ok {
+			metadata[key] = text
+		}
+	}
+	if len(metadata) == 0 {
+		return nil
+	}
+	return metadata
+}
@@ -0,0 +1,121 @@
+package grpcserver
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	catalogv1 "catalogo-produtos/backend/proto/catalog/v1"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProduct converte um produto para a mensagem do protocolo
+func toProduct(product *entities.Product) (*catalogv1.Product, error) {
+	attributes, err := structpb.NewStruct(product.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &catalogv1.Product{
+		Id:                uint64(product.ID),
+		Sku:               product.SKU,
+		Slug:              product.Slug,
+		Name:              product.Name,
+		Image:             product.Image,
+		Description:       product.Description,
+		Price:             toMoney(product.Price),
+		CategoryId:        uint64(product.CategoryID),
+		CategoryName:      product.Category.Name,
+		Attributes:        attributes,
+		AvailableQuantity: product.StockQuantity,
+		Version:           product.Version,
+		CreateTime:        timestamppb.New(product.CreatedAt),
+		UpdateTime:        timestamppb.New(product.UpdatedAt),
+	}
+
+	// Com variantes, o estoque do produto é a soma do estoque delas
+	if len(product.Variants) > 0 {
+		message.AvailableQuantity = 0
+	}
+	for _, variant := range product.Variants {
+		price := product.Price
+		if variant.Price != nil {
+			price = *variant.Price
+		}
+		message.Variants = append(message.Variants, &catalogv1.Variant{
+			Id:                uint64(variant.ID),
+			Sku:               variant.SKU,
+			Options:           variant.Options,
+			Price:             toMoney(price),
+			PriceOverride:     variant.Price != nil,
+			AvailableQuantity: variant.StockQuantity,
+		})
+		message.AvailableQuantity += variant.StockQuantity
+	}
+	return message, nil
+}
+
+// toCategory converte uma categoria para a mensagem do protocolo
+func toCategory(category *entities.Category) *catalogv1.Category {
+	message := &catalogv1.Category{
+		Id:         uint64(category.ID),
+		Name:       category.Name,
+		Version:    category.Version,
+		CreateTime: timestamppb.New(category.CreatedAt),
+		UpdateTime: timestamppb.New(category.UpdatedAt),
+	}
+	if category.ParentID != nil {
+		parentID := uint64(*category.ParentID)
+		message.ParentId = &parentID
+	}
+	return message
+}
+
+// toMoney converte um valor monetário para a mensagem do protocolo, com o decimal exato em texto
+func toMoney(money entities.Money) *catalogv1.Money {
+	return &catalogv1.Money{Amount: money.String(), Currency: money.Currency}
+}
+
+// moneyFrom converte o preço informado em uma requisição
+func moneyFrom(money *catalogv1.Money, field string) (entities.Money, error) {
+	if money == nil {
+		return entities.Money{}, invalidArgument(field, "informe o preço")
+	}
+	price, err := entities.ParseMoney(money.GetAmount(), money.GetCurrency())
+	if err != nil {
+		return entities.Money{}, usecases.NewValidationError("invalid_price", err.Error(),
+			usecases.FieldError{Field: field, Message: err.Error()})
+	}
+	return price, nil
+}
+
+// productInputFrom converte o produto informado em uma requisição nos dados de criação ou substituição
+func productInputFrom(input *catalogv1.ProductInput) (*usecases.ProductInput, error) {
+	if input == nil {
+		return nil, invalidArgument("product", "informe o produto")
+	}
+	price, err := moneyFrom(input.GetPrice(), "product.price")
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecases.ProductInput{
+		Name:        input.GetName(),
+		Image:       input.GetImage(),
+		Description: input.GetDescription(),
+		Price:       price,
+		CategoryID:  uint(input.GetCategoryId()),
+		SKU:         input.GetSku(),
+		Slug:        input.GetSlug(),
+		Attributes:  input.GetAttributes().AsMap(),
+	}, nil
+}
+
+// optionalID converte um ID opcional de uma requisição
+func optionalID(id *uint64) *uint {
+	if id == nil {
+		return nil
+	}
+	value := uint(*id)
+	return &value
+}
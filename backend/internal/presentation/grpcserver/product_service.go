@@ -0,0 +1,197 @@
+package grpcserver
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	catalogv1 "catalogo-produtos/backend/proto/catalog/v1"
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+)
+
+// productService implementa catalogv1.ProductServiceServer sobre ProductUseCase
+type productService struct {
+	catalogv1.UnimplementedProductServiceServer
+	productUseCase usecases.ProductUseCase
+}
+
+// GetProduct busca um produto pelo ID, SKU ou slug
+func (s *productService) GetProduct(ctx context.Context, req *catalogv1.GetProductRequest) (*catalogv1.Product, error) {
+	var product *entities.Product
+	var err error
+	switch key := req.GetKey().(type) {
+	case *catalogv1.GetProductRequest_Id:
+		product, err = s.productUseCase.GetProduct(uint(key.Id))
+	case *catalogv1.GetProductRequest_Sku:
+		product, err = s.productUseCase.GetProductBySKU(key.Sku)
+	case *catalogv1.GetProductRequest_Slug:
+		product, err = s.productUseCase.GetProductBySlug(key.Slug)
+	default:
+		return nil, invalidArgument("key", "informe id, sku ou slug")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toProduct(product)
+}
+
+// ListProducts envia os produtos filtrados à medida que são lidos do banco, sem paginação.
+// O envio é interrompido se o cliente cancelar a chamada.
+func (s *productService) ListProducts(req *catalogv1.ListProductsRequest, stream grpc.ServerStreamingServer[catalogv1.Product]) error {
+	filters, err := productFilterFrom(req)
+	if err != nil {
+		return err
+	}
+
+	return s.productUseCase.ExportProducts(filters, func(product *entities.Product) error {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		message, err := toProduct(product)
+		if err != nil {
+			return err
+		}
+		return stream.Send(message)
+	})
+}
+
+// CreateProduct cria um produto
+func (s *productService) CreateProduct(ctx context.Context, req *catalogv1.CreateProductRequest) (*catalogv1.Product, error) {
+	input, err := productInputFrom(req.GetProduct())
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := s.productUseCase.CreateProduct(ctx, *input)
+	if err != nil {
+		return nil, err
+	}
+	return toProduct(product)
+}
+
+// UpdateProduct substitui o produto ou, com update_mask, altera apenas os campos listados
+func (s *productService) UpdateProduct(ctx context.Context, req *catalogv1.UpdateProductRequest) (*catalogv1.Product, error) {
+	version, err := expectedVersion(req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		input, err := productInputFrom(req.GetProduct())
+		if err != nil {
+			return nil, err
+		}
+		input.Version = version
+
+		product, err := s.productUseCase.UpdateProduct(ctx, uint(req.GetId()), *input)
+		if err != nil {
+			return nil, err
+		}
+		return toProduct(product)
+	}
+
+	patch, err := productPatchFrom(req)
+	if err != nil {
+		return nil, err
+	}
+	patch.Version = version
+	product, _, err := s.productUseCase.PatchProduct(ctx, uint(req.GetId()), *patch)
+	if err != nil {
+		return nil, err
+	}
+	return toProduct(product)
+}
+
+// DeleteProduct remove um produto
+func (s *productService) DeleteProduct(ctx context.Context, req *catalogv1.DeleteProductRequest) (*catalogv1.DeleteProductResponse, error) {
+	version, err := expectedVersion(req.GetVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.productUseCase.DeleteProduct(ctx, uint(req.GetId()), version); err != nil {
+		return nil, err
+	}
+	return &catalogv1.DeleteProductResponse{}, nil
+}
+
+// productPatchFrom converte os campos listados em update_mask em uma atualização parcial.
+// Atributos listados na máscara são substituídos por inteiro, e não mesclados.
+func productPatchFrom(req *catalogv1.UpdateProductRequest) (*usecases.ProductPatch, error) {
+	input := req.GetProduct()
+	if input == nil {
+		return nil, invalidArgument("product", "informe o produto")
+	}
+
+	patch := &usecases.ProductPatch{}
+	for _, path := range req.GetUpdateMask().GetPaths() {
+		switch path {
+		case "name":
+			patch.Name = &input.Name
+		case "price":
+			if input.GetPrice() == nil {
+				return nil, invalidArgument("product.price", "informe o preço")
+			}
+			patch.Price = &input.Price.Amount
+			if input.Price.Currency != "" {
+				patch.Currency = &input.Price.Currency
+			}
+		case "category_id":
+			categoryID := uint(input.GetCategoryId())
+			patch.CategoryID = &categoryID
+		case "sku":
+			patch.SKU = &input.Sku
+		case "slug":
+			patch.Slug = &input.Slug
+		case "image":
+			patch.Image = &input.Image
+		case "description":
+			patch.Description = &input.Description
+		case "attributes":
+			patch.Attributes = input.GetAttributes().AsMap()
+			patch.ClearAttributes = true
+		default:
+			return nil, invalidArgument("update_mask", fmt.Sprintf("campo desconhecido em update_mask: %s", path))
+		}
+	}
+	return patch, nil
+}
+
+// productFilterFrom converte os filtros de ListProducts nos filtros da listagem
+func productFilterFrom(req *catalogv1.ListProductsRequest) (*repositories.ProductFilter, error) {
+	filters := &repositories.ProductFilter{
+		Query:                req.GetQuery(),
+		IncludeSubcategories: req.GetIncludeSubcategories(),
+		HasImage:             req.HasImage,
+	}
+	for _, id := range req.GetCategoryIds() {
+		filters.CategoryIDs = append(filters.CategoryIDs, uint(id))
+	}
+	if req.GetUpdatedAfter() != nil {
+		updatedAfter := req.GetUpdatedAfter().AsTime()
+		filters.UpdatedAfter = &updatedAfter
+	}
+
+	var err error
+	if filters.MinPrice, err = priceFilterFrom(req.GetMinPrice(), "min_price"); err != nil {
+		return nil, err
+	}
+	if filters.MaxPrice, err = priceFilterFrom(req.GetMaxPrice(), "max_price"); err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+// priceFilterFrom converte um filtro de preço decimal não negativo; vazio dispensa o filtro
+func priceFilterFrom(value, field string) (*entities.Money, error) {
+	if value == "" {
+		return nil, nil
+	}
+	price, err := entities.ParseMoney(value, entities.DefaultCurrency)
+	if err != nil || price.Amount < 0 {
+		return nil, invalidArgument(field, fmt.Sprintf("%s inválido: informe um valor não negativo com até duas casas decimais", field))
+	}
+	return &price, nil
+}
@@ -0,0 +1,47 @@
+package grpcserver
+
+import (
+	"catalogo-produtos/backend/internal/domain/usecases"
+	catalogv1 "catalogo-produtos/backend/proto/catalog/v1"
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// NewServer cria o servidor gRPC com os serviços de produtos e categorias. Erros de domínio
+// são convertidos em status gRPC pelos interceptors e métodos de escrita exigem um token de
+// acesso ou uma chave de API; a reflexão permite que ferramentas como grpcurl descubram os serviços sem os arquivos .proto.
+func NewServer(productUseCase usecases.ProductUseCase, categoryUseCase usecases.CategoryUseCase, authUseCase usecases.AuthUseCase, apiKeyUseCase usecases.APIKeyUseCase) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryErrorInterceptor, unaryAuthInterceptor(authUseCase, apiKeyUseCase)),
+		grpc.ChainStreamInterceptor(streamErrorInterceptor),
+	)
+
+	catalogv1.RegisterProductServiceServer(server, &productService{
+		productUseCase: productUseCase,
+	})
+	catalogv1.RegisterCategoryServiceServer(server, &categoryService{
+		categoryUseCase: categoryUseCase,
+	})
+	reflection.Register(server)
+
+	return server
+}
+
+// unaryErrorInterceptor converte o erro retornado por um método unário em status gRPC
+func unaryErrorInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, statusError(info.FullMethod, err)
+	}
+	return resp, nil
+}
+
+// streamErrorInterceptor converte o erro retornado por um método de streaming em status gRPC
+func streamErrorInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := handler(srv, stream); err != nil {
+		return statusError(info.FullMethod, err)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHandler gerencia os endpoints HTTP de administração de chaves de API
+type APIKeyHandler struct {
+	apiKeyUseCase usecases.APIKeyUseCase
+}
+
+// NewAPIKeyHandler cria uma nova instância de APIKeyHandler
+func NewAPIKeyHandler(apiKeyUseCase usecases.APIKeyUseCase) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyUseCase: apiKeyUseCase,
+	}
+}
+
+// GetAPIKeys retorna todas as chaves de API
+// @Summary Listar chaves de API
+// @Description Retorna todas as chaves, inclusive revogadas e expiradas, sem a chave em si
+// @Tags api-keys
+// @Produce json
+// @Success 200 {object} dto.APIKeysResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /api-keys [get]
+func (h *APIKeyHandler) GetAPIKeys(c *gin.Context) {
+	keys, err := h.apiKeyUseCase.GetAPIKeys(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	now := time.Now()
+	data := make([]dto.APIKeyResponse, len(keys))
+	for i := range keys {
+		data[i] = mapToAPIKeyResponse(&keys[i], now)
+	}
+	c.JSON(http.StatusOK, dto.APIKeysResponse{Data: data, Meta: dto.ListMetaV2{Total: len(data)}})
+}
+
+// GetAPIKey retorna uma chave de API pelo ID
+// @Summary Buscar chave de API por ID
+// @Description Retorna uma chave de API pelo ID, sem a chave em si
+// @Tags api-keys
+// @Produce json
+// @Param id path int true "ID da chave"
+// @Success 200 {object} dto.SingleAPIKeyResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /api-keys/{id} [get]
+func (h *APIKeyHandler) GetAPIKey(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	key, err := h.apiKeyUseCase.GetAPIKey(c.Request.Context(), uint(id))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.SingleAPIKeyResponse{Data: mapToAPIKeyResponse(key, time.Now())})
+}
+
+// CreateAPIKey cria uma chave de API
+// @Summary Criar chave de API
+// @Description Cria uma chave de API com os escopos informados. A chave é retornada apenas nesta resposta; somente o hash é armazenado
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Param request body dto.APIKeyCreateRequest true "Dados da chave"
+// @Success 201 {object} dto.APIKeySecretResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /api-keys [post]
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	var req dto.APIKeyCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	scopes := make([]entities.Scope, len(req.Scopes))
+	for i, scope := range req.Scopes {
+		scopes[i] = entities.Scope(scope)
+	}
+
+	key, secret, err := h.apiKeyUseCase.CreateAPIKey(c.Request.Context(), usecases.APIKeyInput{
+		Name:      req.Name,
+		Scopes:    scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, dto.APIKeySecretResponse{Data: mapToAPIKeyResponse(key, time.Now()), Key: secret})
+}
+
+// RotateAPIKey substitui uma chave de API
+// @Summary Rotacionar chave de API
+// @Description Gera uma nova chave para o mesmo registro, mantendo nome, escopos e expiração. A chave anterior deixa de valer imediatamente
+// @Tags api-keys
+// @Produce json
+// @Param id path int true "ID da chave"
+// @Success 200 {object} dto.APIKeySecretResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Failure 409 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /api-keys/{id}/rotate [post]
+func (h *APIKeyHandler) RotateAPIKey(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	key, secret, err := h.apiKeyUseCase.RotateAPIKey(c.Request.Context(), uint(id))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, dto.APIKeySecretResponse{Data: mapToAPIKeyResponse(key, time.Now()), Key: secret})
+}
+
+// RevokeAPIKey revoga uma chave de API
+// @Summary Revogar chave de API
+// @Description Revoga a chave, que deixa de valer imediatamente; o registro continua nas listagens
+// @Tags api-keys
+// @Produce json
+// @Param id path int true "ID da chave"
+// @Success 200 {object} dto.SingleAPIKeyResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /api-keys/{id}/revoke [post]
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	key, err := h.apiKeyUseCase.RevokeAPIKey(c.Request.Context(), uint(id))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.SingleAPIKeyResponse{Data: mapToAPIKeyResponse(key, time.Now())})
+}
+
+// mapToAPIKeyResponse converte uma chave de API para a resposta
+func mapToAPIKeyResponse(key *entities.APIKey, now time.Time) dto.APIKeyResponse {
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+	return dto.APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		Active:     key.IsActive(now),
+		CreatedBy:  key.CreatedBy,
+		CreatedAt:  key.CreatedAt,
+		UpdatedAt:  key.UpdatedAt,
+	}
+}
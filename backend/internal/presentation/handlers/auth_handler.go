@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuthHandler gerencia os endpoints HTTP de cadastro, login e sessões
+type AuthHandler struct {
+	authUseCase usecases.AuthUseCase
+	userUseCase usecases.UserUseCase
+}
+
+// NewAuthHandler cria uma nova instância de AuthHandler
+func NewAuthHandler(authUseCase usecases.AuthUseCase, userUseCase usecases.UserUseCase) *AuthHandler {
+	return &AuthHandler{
+		authUseCase: authUseCase,
+		userUseCase: userUseCase,
+	}
+}
+
+// Register cria uma conta
+// @Summary Criar conta
+// @Description Cria uma conta com o papel viewer; outros papéis são concedidos por um administrador
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.RegisterRequest true "Dados da conta"
+// @Success 201 {object} dto.SingleUserResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 409 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Router /auth/register [post]
+func (h *AuthHandler) Register(c *gin.Context) {
+	var req dto.RegisterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	user, err := h.userUseCase.Register(usecases.UserInput{
+		Email:    req.Email,
+		Name:     req.Name,
+		Password: req.Password,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.SingleUserResponse{Data: mapToUserResponse(user)})
+}
+
+// Login troca e-mail e senha por um par de tokens
+// @Summary Login
+// @Description Verifica as credenciais e inicia uma sessão; os tokens trazem os papéis do usuário
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.LoginRequest true "Credenciais"
+// @Success 200 {object} dto.TokenResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Router /auth/login [post]
+func (h *AuthHandler) Login(c *gin.Context) {
+	var req dto.LoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	tokens, err := h.userUseCase.Login(req.Email, req.Password)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, mapToTokenResponse(tokens))
+}
+
+// ChangePassword troca a senha do usuário autenticado
+// @Summary Trocar senha
+// @Description Troca a senha do usuário autenticado mediante a senha atual. Todas as sessões são encerradas e uma nova é iniciada
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ChangePasswordRequest true "Senha atual e nova senha"
+// @Success 200 {object} dto.TokenResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /auth/password [put]
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	var req dto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	tokens, err := h.userUseCase.ChangePassword(c.Request.Context(), req.CurrentPassword, req.NewPassword)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, mapToTokenResponse(tokens))
+}
+
+// Refresh troca um refresh token por um novo par de tokens
+// @Summary Renovar tokens
+// @Description Troca um refresh token válido por um novo token de acesso e um novo refresh token. O token enviado é revogado; reutilizá-lo encerra a sessão inteira
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} dto.TokenResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Router /auth/refresh [post]
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	var req dto.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	tokens, err := h.authUseCase.Refresh(req.RefreshToken)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, mapToTokenResponse(tokens))
+}
+
+// Revoke encerra a sessão do refresh token
+// @Summary Revogar sessão
+// @Description Revoga o refresh token e todos os renovados a partir do mesmo login (logout). Tokens desconhecidos são ignorados
+// @Tags auth
+// @Accept json
+// @Param request body dto.RefreshTokenRequest true "Refresh token"
+// @Success 204 "Sessão revogada"
+// @Failure 422 {object} dto.ProblemResponse
+// @Router /auth/revoke [post]
+func (h *AuthHandler) Revoke(c *gin.Context) {
+	var req dto.RefreshTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	if err := h.authUseCase.Revoke(req.RefreshToken); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// mapToTokenResponse converte os tokens emitidos para a resposta
+func mapToTokenResponse(tokens *entities.TokenPair) dto.TokenResponse {
+	now := time.Now()
+	return dto.TokenResponse{
+		AccessToken:      tokens.AccessToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int64(tokens.AccessExpiresAt.Sub(now).Seconds()),
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresIn: int64(tokens.RefreshExpiresAt.Sub(now).Seconds()),
+	}
+}
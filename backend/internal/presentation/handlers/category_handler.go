@@ -35,7 +35,7 @@ func NewCategoryHandler(categoryUseCase usecases.CategoryUseCase) *CategoryHandl
 func (h *CategoryHandler) GetCategories(c *gin.Context) {
 	categories, err := h.categoryUseCase.GetCategories()
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Erro ao buscar categorias"})
+		c.Error(err)
 		return
 	}
 
@@ -51,6 +51,30 @@ func (h *CategoryHandler) GetCategories(c *gin.Context) {
 	})
 }
 
+// GetCategoryTree retorna a árvore de categorias
+// @Summary Árvore de categorias
+// @Description Retorna as categorias organizadas hierarquicamente a partir das categorias raiz
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.CategoryTreeResponse
+// @Failure 500 {object} dto.ErrorResponse
+// @Router /categories/tree [get]
+func (h *CategoryHandler) GetCategoryTree(c *gin.Context) {
+	tree, err := h.categoryUseCase.GetCategoryTree()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	nodes := make([]dto.CategoryTreeNode, len(tree))
+	for i, node := range tree {
+		nodes[i] = h.mapToCategoryTreeNode(node)
+	}
+
+	c.JSON(http.StatusOK, dto.CategoryTreeResponse{Data: nodes})
+}
+
 // GetCategory retorna uma categoria específica pelo ID
 // @Summary Buscar categoria por ID
 // @Description Retorna uma categoria específica pelo ID
@@ -58,7 +82,9 @@ func (h *CategoryHandler) GetCategories(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID da categoria"
+// @Param If-None-Match header string false "ETag já conhecida pelo cliente"
 // @Success 200 {object} dto.SingleCategoryResponse
+// @Success 304 "Categoria não alterada desde a ETag informada"
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Router /categories/{id} [get]
@@ -66,13 +92,16 @@ func (h *CategoryHandler) GetCategory(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID inválido"})
+		c.Error(errInvalidID)
 		return
 	}
 
 	category, err := h.categoryUseCase.GetCategory(uint(id))
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Categoria não encontrada"})
+		c.Error(err)
+		return
+	}
+	if writeETag(c, categoryTag(*category)) {
 		return
 	}
 
@@ -90,17 +119,22 @@ func (h *CategoryHandler) GetCategory(c *gin.Context) {
 // @Param category body dto.CategoryCreateRequest true "Dados da categoria"
 // @Success 201 {object} dto.SingleCategoryResponse
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
 // @Router /categories [post]
 func (h *CategoryHandler) CreateCategory(c *gin.Context) {
 	var req dto.CategoryCreateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Dados inválidos"})
+		c.Error(bindingError(err, "Dados inválidos"))
 		return
 	}
 
-	category, err := h.categoryUseCase.CreateCategory(req.Name)
+	category, err := h.categoryUseCase.CreateCategory(c.Request.Context(), req.Name, req.ParentID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -116,68 +150,296 @@ func (h *CategoryHandler) CreateCategory(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID da categoria"
+// @Param If-Match header string true "ETag obtida na leitura da categoria, ou * para ignorar a versão"
 // @Param category body dto.CategoryUpdateRequest true "Dados da categoria"
 // @Success 200 {object} dto.SingleCategoryResponse
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 412 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Failure 428 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
 // @Router /categories/{id} [put]
 func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID inválido"})
+		c.Error(errInvalidID)
+		return
+	}
+
+	version, ok := requireIfMatch(c)
+	if !ok {
 		return
 	}
 
 	var req dto.CategoryUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Dados inválidos"})
+		c.Error(bindingError(err, "Dados inválidos"))
 		return
 	}
 
-	category, err := h.categoryUseCase.UpdateCategory(uint(id), req.Name)
+	category, err := h.categoryUseCase.UpdateCategory(c.Request.Context(), uint(id), req.Name, req.ParentID, version)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 
+	c.Header("ETag", categoryTag(*category))
 	c.JSON(http.StatusOK, dto.SingleCategoryResponse{
 		Data: h.mapToCategoryResponse(*category),
 	})
 }
 
+// PatchCategory atualiza parcialmente uma categoria
+// @Summary Atualizar categoria parcialmente
+// @Description Aplica um JSON Merge Patch (RFC 7396): apenas os campos enviados são alterados; parent_id null torna a categoria raiz. Retorna os campos efetivamente alterados
+// @Tags categories
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path int true "ID da categoria"
+// @Param If-Match header string true "ETag obtida na leitura da categoria, ou * para ignorar a versão"
+// @Param category body dto.CategoryPatchRequest true "Campos a alterar"
+// @Success 200 {object} dto.PatchCategoryResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 412 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Failure 428 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /categories/{id} [patch]
+func (h *CategoryHandler) PatchCategory(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	version, ok := requireIfMatch(c)
+	if !ok {
+		return
+	}
+
+	body, err := bindMergePatch(c, "name", "parent_id")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	patch := usecases.CategoryPatch{Version: version}
+	if patch.Name, err = body.string("name"); err != nil {
+		c.Error(err)
+		return
+	}
+	if patch.ParentID, patch.ParentSet, err = body.id("parent_id"); err != nil {
+		c.Error(err)
+		return
+	}
+
+	category, changed, err := h.categoryUseCase.PatchCategory(c.Request.Context(), uint(id), patch)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("ETag", categoryTag(*category))
+	c.JSON(http.StatusOK, dto.PatchCategoryResponse{
+		Data:    h.mapToCategoryResponse(*category),
+		Changed: changed,
+	})
+}
+
 // DeleteCategory remove uma categoria
 // @Summary Deletar categoria
-// @Description Remove uma categoria
+// @Description Remove uma categoria. Sem modo, falha com 409 se houver produtos ou subcategorias ligados a ela
 // @Tags categories
 // @Accept json
 // @Produce json
 // @Param id path int true "ID da categoria"
+// @Param mode query string false "Modo de remoção" Enums(restrict, reassign, cascade)
+// @Param target query int false "Categoria destino (obrigatória no modo reassign)"
+// @Param If-Match header string true "ETag obtida na leitura da categoria, ou * para ignorar a versão"
 // @Success 200 {object} dto.MessageResponse
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 412 {object} dto.ErrorResponse
+// @Failure 428 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
 // @Router /categories/{id} [delete]
 func (h *CategoryHandler) DeleteCategory(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID inválido"})
+		c.Error(errInvalidID)
+		return
+	}
+
+	var req dto.CategoryDeleteRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(bindingError(err, "Parâmetros de remoção inválidos"))
+		return
+	}
+
+	version, ok := requireIfMatch(c)
+	if !ok {
 		return
 	}
 
-	err = h.categoryUseCase.DeleteCategory(uint(id))
+	err = h.categoryUseCase.DeleteCategory(c.Request.Context(), uint(id), usecases.DeleteCategoryOptions{
+		Mode:     usecases.DeleteMode(req.Mode),
+		TargetID: req.Target,
+		Version:  version,
+	})
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 
 	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Categoria removida com sucesso"})
 }
 
+// GetCategoryAttributes retorna o schema de atributos de uma categoria
+// @Summary Listar atributos da categoria
+// @Description Retorna o schema efetivo de atributos da categoria, incluindo os herdados das categorias ancestrais
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da categoria"
+// @Success 200 {object} dto.CategoryAttributesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /categories/{id}/attributes [get]
+func (h *CategoryHandler) GetCategoryAttributes(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	attributes, err := h.categoryUseCase.GetAttributes(uint(id))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapToAttributesResponse(uint(id), attributes))
+}
+
+// SetCategoryAttributes substitui os atributos definidos pela categoria
+// @Summary Definir atributos da categoria
+// @Description Substitui os atributos definidos pela própria categoria. Atributos com a mesma chave de uma categoria ancestral a sobrescrevem
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path int true "ID da categoria"
+// @Param attributes body dto.CategoryAttributesRequest true "Atributos da categoria"
+// @Success 200 {object} dto.CategoryAttributesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /categories/{id}/attributes [put]
+func (h *CategoryHandler) SetCategoryAttributes(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	var req dto.CategoryAttributesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	attributes := make([]entities.CategoryAttribute, len(req.Attributes))
+	for i, attribute := range req.Attributes {
+		attributes[i] = entities.CategoryAttribute{
+			Key:      attribute.Key,
+			Label:    attribute.Label,
+			Type:     entities.AttributeType(attribute.Type),
+			Unit:     attribute.Unit,
+			Required: attribute.Required,
+			Options:  attribute.Options,
+		}
+	}
+
+	schema, err := h.categoryUseCase.SetAttributes(c.Request.Context(), uint(id), attributes)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.mapToAttributesResponse(uint(id), schema))
+}
+
+// mapToAttributesResponse converte o schema efetivo para DTO de resposta
+func (h *CategoryHandler) mapToAttributesResponse(categoryID uint, attributes []entities.CategoryAttribute) dto.CategoryAttributesResponse {
+	data := make([]dto.CategoryAttributeResponse, len(attributes))
+	for i, attribute := range attributes {
+		options := attribute.Options
+		if options == nil {
+			options = []string{}
+		}
+		data[i] = dto.CategoryAttributeResponse{
+			Key:        attribute.Key,
+			Label:      attribute.Label,
+			Type:       string(attribute.Type),
+			Unit:       attribute.Unit,
+			Required:   attribute.Required,
+			Options:    options,
+			CategoryID: attribute.CategoryID,
+			Inherited:  attribute.CategoryID != categoryID,
+		}
+	}
+	return dto.CategoryAttributesResponse{Data: data}
+}
+
 // mapToCategoryResponse converte entidade para DTO de resposta
 func (h *CategoryHandler) mapToCategoryResponse(category entities.Category) dto.CategoryResponse {
+	// Breadcrumbs vão da raiz até a própria categoria
+	breadcrumbs := make([]dto.CategoryBreadcrumb, 0, len(category.Ancestors)+1)
+	for _, ancestor := range category.Ancestors {
+		breadcrumbs = append(breadcrumbs, dto.CategoryBreadcrumb{ID: ancestor.ID, Name: ancestor.Name})
+	}
+	breadcrumbs = append(breadcrumbs, dto.CategoryBreadcrumb{ID: category.ID, Name: category.Name})
+
 	return dto.CategoryResponse{
-		ID:        category.ID,
-		Name:      category.Name,
-		CreatedAt: category.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: category.UpdatedAt.Format(time.RFC3339),
+		ID:          category.ID,
+		Name:        category.Name,
+		ParentID:    category.ParentID,
+		Breadcrumbs: breadcrumbs,
+		Version:     category.Version,
+		CreatedAt:   category.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   category.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// mapToCategoryTreeNode converte um nó da árvore para DTO de resposta
+func (h *CategoryHandler) mapToCategoryTreeNode(node entities.CategoryNode) dto.CategoryTreeNode {
+	children := make([]dto.CategoryTreeNode, len(node.Children))
+	for i, child := range node.Children {
+		children[i] = h.mapToCategoryTreeNode(child)
+	}
+
+	return dto.CategoryTreeNode{
+		ID:       node.ID,
+		Name:     node.Name,
+		ParentID: node.ParentID,
+		Children: children,
 	}
 }
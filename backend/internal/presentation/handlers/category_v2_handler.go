@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CategoryV2Handler gerencia os endpoints HTTP de categorias da API v2. A representação
+// de uma categoria não mudou; os endpoints sem diferença em relação à v1 vêm de CategoryHandler.
+type CategoryV2Handler struct {
+	*CategoryHandler
+}
+
+// NewCategoryV2Handler cria uma nova instância de CategoryV2Handler
+func NewCategoryV2Handler(categoryUseCase usecases.CategoryUseCase) *CategoryV2Handler {
+	return &CategoryV2Handler{
+		CategoryHandler: NewCategoryHandler(categoryUseCase),
+	}
+}
+
+// GetCategories retorna todas as categorias
+// @Summary Listar categorias (v2)
+// @Description Retorna todas as categorias, com o total em meta
+// @Tags categories-v2
+// @Produce json
+// @Success 200 {object} dto.CategoriesV2Response
+// @Failure 500 {object} dto.ProblemResponse
+// @Router /v2/categories [get]
+func (h *CategoryV2Handler) GetCategories(c *gin.Context) {
+	categories, err := h.categoryUseCase.GetCategories()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	data := make([]dto.CategoryResponse, len(categories))
+	for i, category := range categories {
+		data[i] = h.mapToCategoryResponse(category)
+	}
+
+	c.JSON(http.StatusOK, dto.CategoriesV2Response{
+		Data: data,
+		Meta: dto.ListMetaV2{Total: len(data)},
+	})
+}
+
+// DeleteCategory remove uma categoria
+// @Summary Deletar categoria (v2)
+// @Description Remove uma categoria. Sem modo, falha com 409 se houver produtos ou subcategorias ligados a ela
+// @Tags categories-v2
+// @Param id path int true "ID da categoria"
+// @Param mode query string false "Modo de remoção" Enums(restrict, reassign, cascade)
+// @Param target query int false "Categoria destino (obrigatória no modo reassign)"
+// @Param If-Match header string true "ETag obtida na leitura da categoria, ou * para ignorar a versão"
+// @Success 204 "Categoria removida"
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Failure 409 {object} dto.ProblemResponse
+// @Failure 412 {object} dto.ProblemResponse
+// @Failure 428 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /v2/categories/{id} [delete]
+func (h *CategoryV2Handler) DeleteCategory(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	var req dto.CategoryDeleteRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(bindingError(err, "Parâmetros de remoção inválidos"))
+		return
+	}
+
+	version, ok := requireIfMatch(c)
+	if !ok {
+		return
+	}
+
+	err = h.categoryUseCase.DeleteCategory(c.Request.Context(), uint(id), usecases.DeleteCategoryOptions{
+		Mode:     usecases.DeleteMode(req.Mode),
+		TargetID: req.Target,
+		Version:  version,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
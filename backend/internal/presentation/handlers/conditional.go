@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errIfMatchRequired indica uma escrita sem a versão esperada do recurso
+var errIfMatchRequired = &usecases.Error{
+	Kind:    usecases.KindPreconditionRequired,
+	Code:    "if_match_required",
+	Message: "Cabeçalho If-Match obrigatório: envie a ETag obtida na leitura do recurso",
+}
+
+// representationTag monta a ETag de uma representação que inclui dados alterados sem mudar a
+// versão do recurso: a versão seguida de um resumo desses dados, ex: "v3-9f2c41d07a6e5b18"
+func representationTag(version int64, parts ...int64) string {
+	hash := fnv.New64a()
+	for _, part := range parts {
+		hash.Write(strconv.AppendInt(nil, part, 10))
+		hash.Write([]byte{','})
+	}
+	return `"v` + strconv.FormatInt(version, 10) + "-" + fmt.Sprintf("%016x", hash.Sum64()) + `"`
+}
+
+// productTag monta a ETag de um produto. Movimentações de estoque e alterações da categoria não mudam a
+// versão do produto, mas aparecem no corpo, então entram na ETag.
+func productTag(product entities.Product) string {
+	parts := []int64{product.StockQuantity, int64(product.Category.ID), product.Category.Version}
+	for _, variant := range product.Variants {
+		parts = append(parts, int64(variant.ID), variant.StockQuantity)
+	}
+	return representationTag(product.Version, parts...)
+}
+
+// categoryTag monta a ETag de uma categoria; os breadcrumbs mudam quando um ancestral é alterado
+func categoryTag(category entities.Category) string {
+	parts := make([]int64, 0, 2*len(category.Ancestors))
+	for _, ancestor := range category.Ancestors {
+		parts = append(parts, int64(ancestor.ID), ancestor.Version)
+	}
+	return representationTag(category.Version, parts...)
+}
+
+// writeETag envia a ETag da representação e, se ela coincidir com If-None-Match, responde 304.
+// Retorna true quando a resposta já foi enviada.
+func writeETag(c *gin.Context, tag string) bool {
+	c.Header("ETag", tag)
+
+	ifNoneMatch := c.GetHeader("If-None-Match")
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		// If-None-Match usa comparação fraca: W/"v3" equivale a "v3"
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == tag {
+			c.Status(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
+
+// requireIfMatch lê a versão esperada do cabeçalho If-Match, obrigatório em escritas.
+// "*" aceita qualquer versão (retorna usecases.AnyVersion). Apenas a versão da ETag é conferida, de modo que
+// movimentações de estoque não invalidam uma edição. Sem o cabeçalho registra um erro 428; com uma ETag
+// que não pode corresponder a nenhuma versão, 412. Retorna false quando houve erro.
+func requireIfMatch(c *gin.Context) (int64, bool) {
+	ifMatch := strings.TrimSpace(c.GetHeader("If-Match"))
+	if ifMatch == "" {
+		c.Error(errIfMatchRequired)
+		return 0, false
+	}
+	if ifMatch == "*" {
+		return usecases.AnyVersion, true
+	}
+
+	// If-Match usa comparação forte: ETags fracas nunca correspondem
+	tag, opened := strings.CutPrefix(ifMatch, `"v`)
+	tag, closed := strings.CutSuffix(tag, `"`)
+	versionText, digest, hasDigest := strings.Cut(tag, "-")
+	version, err := strconv.ParseInt(versionText, 10, 64)
+	if !opened || !closed || err != nil || version <= 0 || strconv.FormatInt(version, 10) != versionText ||
+		(hasDigest && !isTagDigest(digest)) {
+		c.Error(usecases.ErrVersionMismatch)
+		return 0, false
+	}
+	return version, true
+}
+
+// isTagDigest informa se o texto é o resumo de uma ETag montada por representationTag
+func isTagDigest(digest string) bool {
+	if len(digest) != 16 {
+		return false
+	}
+	for _, r := range digest {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
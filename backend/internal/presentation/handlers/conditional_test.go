@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestProductTag(t *testing.T) {
+	base := entities.Product{
+		Version:       3,
+		StockQuantity: 10,
+		Category:      entities.Category{ID: 2, Version: 1},
+		Variants:      []entities.ProductVariant{{ID: 7, StockQuantity: 4}},
+	}
+
+	tests := []struct {
+		name      string
+		change    func(product *entities.Product)
+		wantEqual bool
+	}{
+		{name: "mesma representação", change: func(product *entities.Product) {}, wantEqual: true},
+		{name: "nova versão", change: func(product *entities.Product) { product.Version++ }},
+		{name: "estoque do produto", change: func(product *entities.Product) { product.StockQuantity-- }},
+		{name: "estoque da variante", change: func(product *entities.Product) { product.Variants[0].StockQuantity-- }},
+		{name: "categoria renomeada", change: func(product *entities.Product) { product.Category.Version++ }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := base
+			product.Variants = append([]entities.ProductVariant(nil), base.Variants...)
+			tt.change(&product)
+
+			if got := productTag(product) == productTag(base); got != tt.wantEqual {
+				t.Errorf("productTag igual = %v, esperado %v", got, tt.wantEqual)
+			}
+		})
+	}
+}
+
+func TestCategoryTag(t *testing.T) {
+	parentID := uint(1)
+	category := entities.Category{ID: 2, ParentID: &parentID, Version: 5, Ancestors: []entities.Category{{ID: 1, Version: 1}}}
+	renamed := category
+	renamed.Ancestors = []entities.Category{{ID: 1, Version: 2}}
+
+	if categoryTag(category) == categoryTag(renamed) {
+		t.Error("categoryTag não mudou com o ancestral renomeado")
+	}
+}
+
+func TestRequireIfMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tag := productTag(entities.Product{Version: 3, StockQuantity: 10})
+
+	tests := []struct {
+		ifMatch     string
+		wantVersion int64
+		wantCode    string
+	}{
+		{ifMatch: tag, wantVersion: 3},
+		{ifMatch: `"v3"`, wantVersion: 3},
+		{ifMatch: "*", wantVersion: usecases.AnyVersion},
+		{ifMatch: "", wantCode: "if_match_required"},
+		{ifMatch: "W/" + tag, wantCode: "version_mismatch"},
+		{ifMatch: `"v3-xyz"`, wantCode: "version_mismatch"},
+		{ifMatch: `"v03"`, wantCode: "version_mismatch"},
+		{ifMatch: `"v0"`, wantCode: "version_mismatch"},
+		{ifMatch: `v3`, wantCode: "version_mismatch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ifMatch, func(t *testing.T) {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodPut, "/api/products/1", nil)
+			c.Request.Header.Set("If-Match", tt.ifMatch)
+
+			version, ok := requireIfMatch(c)
+			if tt.wantCode != "" {
+				ucErr, isError := c.Errors.Last().Err.(*usecases.Error)
+				if ok || !isError || ucErr.Code != tt.wantCode {
+					t.Fatalf("requireIfMatch(%q) = %d, %v, erros %v; esperado %s", tt.ifMatch, version, ok, c.Errors, tt.wantCode)
+				}
+				return
+			}
+			if !ok || version != tt.wantVersion {
+				t.Errorf("requireIfMatch(%q) = %d, %v; esperado %d", tt.ifMatch, version, ok, tt.wantVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	// errInvalidID indica um ID de rota que não é um número válido
+	errInvalidID = usecases.NewBadRequestError("invalid_id", "ID inválido")
+	// errInvalidVariantID indica um ID de variante que não é um número válido
+	errInvalidVariantID = usecases.NewBadRequestError("invalid_id", "ID da variante inválido")
+	// errUnreadableCSV indica uma planilha que não pôde ser lida
+	errUnreadableCSV = usecases.NewBadRequestError("unreadable_file", "Não foi possível ler a planilha")
+)
+
+// invalidCSV indica uma planilha legível, mas fora do formato esperado
+func invalidCSV(message string) error {
+	return usecases.NewValidationError("invalid_csv", message)
+}
+
+// invalidParam indica um parâmetro de query com valor inválido
+func invalidParam(name, message string) error {
+	return usecases.NewValidationError("invalid_parameter", message, usecases.FieldError{Field: name, Message: message})
+}
+
+// invalidBodyField indica um campo do corpo com tipo ou valor inválido
+func invalidBodyField(field, message string) error {
+	return usecases.NewValidationError("invalid_field", message, usecases.FieldError{Field: field, Message: message})
+}
+
+// invalidPrice converte uma falha ao interpretar o preço em erro de validação do campo price
+func invalidPrice(err error) error {
+	return usecases.NewValidationError("invalid_price", err.Error(),
+		usecases.FieldError{Field: "price", Message: err.Error()})
+}
+
+func init() {
+	// Reportar os campos inválidos pelos nomes da API (json ou form), e não pelos nomes Go
+	if validate, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+			for _, tag := range []string{"json", "form"} {
+				name := strings.Split(field.Tag.Get(tag), ",")[0]
+				if name == "-" {
+					return ""
+				}
+				if name != "" {
+					return name
+				}
+			}
+			return field.Name
+		})
+		// currency aceita apenas as moedas suportadas por Money, e não qualquer código ISO 4217
+		validate.RegisterValidation("currency", func(fl validator.FieldLevel) bool {
+			return entities.IsSupportedCurrency(fl.Field().String())
+		})
+	}
+}
+
+// bindingError converte uma falha de binding em erro de domínio: regras de validação
+// violadas e campos com o tipo errado viram 422 com o detalhe de cada campo; corpo ou
+// parâmetros malformados, 400
+func bindingError(err error, message string) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return invalidBodyField(typeErr.Field, typeErr.Field+" deve ser "+jsonTypeName(typeErr.Type))
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return usecases.NewBadRequestError("malformed_request", message)
+	}
+
+	fields := make([]usecases.FieldError, len(validationErrors))
+	for i, fieldErr := range validationErrors {
+		fields[i] = usecases.FieldError{Field: fieldPath(fieldErr), Message: validationMessage(fieldErr)}
+	}
+	return usecases.NewValidationError("invalid_request", message, fields...)
+}
+
+// jsonTypeName descreve o tipo JSON esperado para um campo do tipo Go informado
+func jsonTypeName(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "um texto"
+	case reflect.Bool:
+		return "verdadeiro ou falso"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "um número"
+	case reflect.Slice, reflect.Array:
+		return "uma lista"
+	default:
+		return "um objeto"
+	}
+}
+
+// fieldPath retorna o caminho do campo sem o nome da struct raiz (ex: operations[0].action)
+func fieldPath(fieldErr validator.FieldError) string {
+	namespace := fieldErr.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+// validationMessage descreve a regra de validação violada
+func validationMessage(fieldErr validator.FieldError) string {
+	switch fieldErr.Tag() {
+	case "required", "required_unless":
+		return "é obrigatório"
+	case "max":
+		return "deve ter no máximo " + fieldErr.Param()
+	case "min":
+		return "deve ter no mínimo " + fieldErr.Param()
+	case "gt":
+		return "deve ser maior que " + fieldErr.Param()
+	case "oneof":
+		return "deve ser um de: " + strings.ReplaceAll(fieldErr.Param(), " ", ", ")
+	case "currency":
+		return "deve ser uma moeda suportada: " + strings.Join(entities.SupportedCurrencies(), ", ")
+	default:
+		return "é inválido"
+	}
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// exportFlushInterval define a cada quantas linhas a resposta é enviada ao cliente
+const exportFlushInterval = 500
+
+// exportCSVHeader lista as colunas do CSV exportado
+var exportCSVHeader = []string{
+	"id", "sku", "slug", "name", "description", "image", "price", "currency",
+	"category_id", "category", "stock_quantity", "attributes", "created_at", "updated_at",
+}
+
+// ExportHandler gerencia os endpoints HTTP de exportação
+type ExportHandler struct {
+	productUseCase usecases.ProductUseCase
+}
+
+// NewExportHandler cria uma nova instância de ExportHandler
+func NewExportHandler(productUseCase usecases.ProductUseCase) *ExportHandler {
+	return &ExportHandler{
+		productUseCase: productUseCase,
+	}
+}
+
+// ExportProducts exporta o catálogo em CSV ou NDJSON
+// @Summary Exportar produtos
+// @Description Exporta todos os produtos que atendem aos filtros da listagem (sem paginação), lidos diretamente do cursor do banco e enviados à medida que são lidos. Inclui o nome da categoria e as datas de criação e atualização
+// @Tags exports
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param format query string false "Formato do arquivo (padrão csv)" Enums(csv, ndjson)
+// @Param q query string false "Busca textual por nome e descrição"
+// @Param name query string false "Filtrar por nome do produto"
+// @Param category query string false "Filtrar por nome da categoria"
+// @Param category_id query []int false "Filtrar por ID da categoria (repetível)" collectionFormat(multi)
+// @Param include_subcategories query bool false "Incluir produtos das subcategorias de category_id"
+// @Param currency query string false "Filtrar pela moeda; também é a moeda de min_price e max_price (padrão BRL)"
+// @Param min_price query number false "Preço mínimo"
+// @Param max_price query number false "Preço máximo"
+// @Param created_after query string false "Criados a partir de (YYYY-MM-DD ou RFC3339)"
+// @Param updated_after query string false "Atualizados a partir de (YYYY-MM-DD ou RFC3339)"
+// @Param has_image query bool false "Filtrar produtos com ou sem imagem"
+// @Param sort query string false "Ordenação, ex: price,-created_at"
+// @Success 200 {array} dto.ProductExportRow
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 500 {object} dto.ProblemResponse
+// @Router /exports/products [get]
+func (h *ExportHandler) ExportProducts(c *gin.Context) {
+	format := c.DefaultQuery("format", "csv")
+	if format != "csv" && format != "ndjson" {
+		c.Error(invalidParam("format", "format inválido: use csv ou ndjson"))
+		return
+	}
+
+	filters, err := bindProductFilter(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	var (
+		started   bool
+		count     int
+		csvWriter *csv.Writer
+		encoder   *json.Encoder
+	)
+
+	// Os cabeçalhos só são enviados com a primeira linha, para que falhas na
+	// consulta ainda possam ser respondidas com 500
+	start := func() {
+		started = true
+		filename := fmt.Sprintf("produtos-%s.%s", time.Now().Format("20060102-150405"), format)
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		if format == "csv" {
+			c.Header("Content-Type", "text/csv; charset=utf-8")
+			c.Status(http.StatusOK)
+			csvWriter = csv.NewWriter(c.Writer)
+			csvWriter.Write(exportCSVHeader)
+		} else {
+			c.Header("Content-Type", "application/x-ndjson")
+			c.Status(http.StatusOK)
+			encoder = json.NewEncoder(c.Writer)
+		}
+	}
+
+	flush := func() error {
+		if csvWriter != nil {
+			csvWriter.Flush()
+			if err := csvWriter.Error(); err != nil {
+				return err
+			}
+		}
+		c.Writer.Flush()
+		return nil
+	}
+
+	err = h.productUseCase.ExportProducts(filters, func(product *entities.Product) error {
+		if !started {
+			start()
+		}
+
+		row := mapToExportRow(product)
+		if csvWriter != nil {
+			if err := csvWriter.Write(exportCSVRecord(row)); err != nil {
+				return err
+			}
+		} else if err := encoder.Encode(row); err != nil {
+			return err
+		}
+
+		count++
+		if count%exportFlushInterval == 0 {
+			return flush()
+		}
+		return nil
+	})
+
+	if err != nil {
+		if !started {
+			c.Error(err)
+			return
+		}
+		// A resposta já começou: só resta interromper o envio
+		log.Printf("Exportação de produtos interrompida após %d linhas: %v", count, err)
+		return
+	}
+
+	if !started {
+		start()
+	}
+	if err := flush(); err != nil {
+		log.Printf("Erro ao finalizar exportação de produtos: %v", err)
+	}
+}
+
+// mapToExportRow converte entidade para a linha exportada
+func mapToExportRow(product *entities.Product) dto.ProductExportRow {
+	return dto.ProductExportRow{
+		ID:            product.ID,
+		SKU:           product.SKU,
+		Slug:          product.Slug,
+		Name:          product.Name,
+		Description:   product.Description,
+		Image:         product.Image,
+		Price:         product.Price.String(),
+		Currency:      product.Price.Currency,
+		CategoryID:    product.CategoryID,
+		Category:      product.Category.Name,
+		StockQuantity: product.StockQuantity,
+		Attributes:    product.Attributes,
+		CreatedAt:     product.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     product.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// exportCSVRecord converte a linha exportada nas colunas do CSV, na ordem de exportCSVHeader
+func exportCSVRecord(row dto.ProductExportRow) []string {
+	attributes, _ := json.Marshal(row.Attributes)
+	return []string{
+		strconv.FormatUint(uint64(row.ID), 10),
+		row.SKU,
+		row.Slug,
+		row.Name,
+		row.Description,
+		row.Image,
+		row.Price,
+		row.Currency,
+		strconv.FormatUint(uint64(row.CategoryID), 10),
+		row.Category,
+		strconv.FormatInt(row.StockQuantity, 10),
+		string(attributes),
+		row.CreatedAt,
+		row.UpdatedAt,
+	}
+}
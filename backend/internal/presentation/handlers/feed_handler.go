@@ -0,0 +1,272 @@
+package handlers
+
+import (
+	"bufio"
+	"catalogo-produtos/backend/internal/config"
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// googleNamespace é o namespace dos atributos do Google Merchant
+const googleNamespace = "http://base.google.com/ns/1.0"
+
+// feedTSVHeader lista as colunas do feed TSV, na ordem de feedTSVRecord
+var feedTSVHeader = []string{
+	"id", "title", "description", "link", "image_link", "price", "availability",
+	"product_type", "condition", "identifier_exists", "item_group_id",
+}
+
+// FeedHandler gerencia os endpoints HTTP dos feeds de produtos
+type FeedHandler struct {
+	productUseCase  usecases.ProductUseCase
+	categoryUseCase usecases.CategoryUseCase
+	config          config.FeedConfig
+}
+
+// NewFeedHandler cria uma nova instância de FeedHandler
+func NewFeedHandler(productUseCase usecases.ProductUseCase, categoryUseCase usecases.CategoryUseCase, cfg config.FeedConfig) *FeedHandler {
+	return &FeedHandler{
+		productUseCase:  productUseCase,
+		categoryUseCase: categoryUseCase,
+		config:          cfg,
+	}
+}
+
+// GetMerchantFeed gera o feed de produtos para o Google Merchant
+// @Summary Feed do Google Merchant
+// @Description Gera o feed de produtos em XML (RSS 2.0 com namespace g:) ou TSV. Produtos com variantes geram um item por variante, agrupados por g:item_group_id. Os links usam FEED_BASE_URL e FEED_PRODUCT_PATH; imagens com caminho relativo são resolvidas a partir de FEED_BASE_URL
+// @Tags feeds
+// @Produce application/rss+xml
+// @Produce text/tab-separated-values
+// @Param format query string false "Formato do feed (padrão xml)" Enums(xml, tsv)
+// @Param category_id query []int false "Incluir apenas estas categorias (repetível)" collectionFormat(multi)
+// @Param include_subcategories query bool false "Incluir também as subcategorias de category_id"
+// @Success 200 {string} string "Feed de produtos"
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 500 {object} dto.ProblemResponse
+// @Router /feeds/merchant [get]
+func (h *FeedHandler) GetMerchantFeed(c *gin.Context) {
+	format := c.DefaultQuery("format", "xml")
+	if format != "xml" && format != "tsv" {
+		c.Error(invalidParam("format", "format inválido: use xml ou tsv"))
+		return
+	}
+
+	baseURL := strings.TrimRight(h.config.BaseURL, "/")
+
+	filters, err := bindProductFilter(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// O product_type usa o caminho completo da categoria (ex: Eletrônicos > Celulares)
+	categories, err := h.categoryUseCase.GetCategories()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	productTypes := make(map[uint]string, len(categories))
+	for _, category := range categories {
+		names := make([]string, 0, len(category.Ancestors)+1)
+		for _, ancestor := range category.Ancestors {
+			names = append(names, ancestor.Name)
+		}
+		productTypes[category.ID] = strings.Join(append(names, category.Name), " > ")
+	}
+
+	writer := bufio.NewWriter(c.Writer)
+	var encoder *xml.Encoder
+	started := false
+
+	// Cabeçalhos só são enviados com o primeiro item, para que falhas ainda gerem 500
+	start := func() error {
+		started = true
+		if format == "tsv" {
+			c.Header("Content-Type", "text/tab-separated-values; charset=utf-8")
+			c.Status(http.StatusOK)
+			_, err := writer.WriteString(strings.Join(feedTSVHeader, "\t") + "\n")
+			return err
+		}
+
+		c.Header("Content-Type", "application/rss+xml; charset=utf-8")
+		c.Status(http.StatusOK)
+		fmt.Fprintf(writer, "%s<rss version=\"2.0\" xmlns:g=%q>\n<channel>\n", xml.Header, googleNamespace)
+		encoder = xml.NewEncoder(writer)
+		encoder.Indent("", "  ")
+		channel := []xml.StartElement{{Name: xml.Name{Local: "title"}}, {Name: xml.Name{Local: "link"}}, {Name: xml.Name{Local: "description"}}}
+		for i, value := range []string{h.config.Title, baseURL, h.config.Description} {
+			if err := encoder.EncodeElement(value, channel[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err = h.productUseCase.ExportProducts(filters, func(product *entities.Product) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+
+		for _, item := range h.buildFeedItems(product, baseURL, productTypes[product.CategoryID]) {
+			if format == "tsv" {
+				if _, err := writer.WriteString(strings.Join(feedTSVRecord(item), "\t") + "\n"); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := encoder.EncodeElement(item, xml.StartElement{Name: xml.Name{Local: "item"}}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		if !started {
+			c.Error(err)
+			return
+		}
+		log.Printf("Geração do feed interrompida: %v", err)
+		return
+	}
+
+	if !started {
+		if err := start(); err != nil {
+			log.Printf("Erro ao gerar o feed: %v", err)
+			return
+		}
+	}
+	if format == "xml" {
+		encoder.Flush()
+		writer.WriteString("\n</channel>\n</rss>\n")
+	}
+	if err := writer.Flush(); err != nil {
+		log.Printf("Erro ao enviar o feed: %v", err)
+	}
+}
+
+// buildFeedItems converte um produto nos itens do feed: um por variante ou um único item sem variantes
+func (h *FeedHandler) buildFeedItems(product *entities.Product, baseURL, productType string) []dto.FeedItem {
+	description := product.Description
+	if description == "" {
+		description = product.Name
+	}
+
+	base := dto.FeedItem{
+		ID:               product.SKU,
+		Title:            product.Name,
+		Description:      description,
+		Link:             baseURL + h.productPath(product),
+		ImageLink:        absoluteURL(baseURL, product.Image),
+		Price:            formatFeedPrice(product.Price),
+		Availability:     feedAvailability(product.StockQuantity),
+		ProductType:      productType,
+		Condition:        "new",
+		IdentifierExists: "no",
+	}
+	if base.ID == "" {
+		base.ID = strconv.FormatUint(uint64(product.ID), 10)
+	}
+
+	if len(product.Variants) == 0 {
+		return []dto.FeedItem{base}
+	}
+
+	items := make([]dto.FeedItem, len(product.Variants))
+	for i, variant := range product.Variants {
+		item := base
+		item.ID = variant.SKU
+		item.ItemGroupID = base.ID
+		item.Title = product.Name + " - " + variantLabel(product.Options, variant)
+		item.Link = base.Link + "?variant=" + url.QueryEscape(variant.SKU)
+		item.Availability = feedAvailability(variant.StockQuantity)
+		if variant.Image != "" {
+			item.ImageLink = absoluteURL(baseURL, variant.Image)
+		}
+		if variant.Price != nil {
+			item.Price = formatFeedPrice(*variant.Price)
+		}
+		items[i] = item
+	}
+	return items
+}
+
+// productPath monta o caminho da página do produto a partir de FEED_PRODUCT_PATH
+func (h *FeedHandler) productPath(product *entities.Product) string {
+	path := h.config.ProductPath
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return strings.NewReplacer(
+		"{slug}", url.PathEscape(product.Slug),
+		"{id}", strconv.FormatUint(uint64(product.ID), 10),
+		"{sku}", url.PathEscape(product.SKU),
+	).Replace(path)
+}
+
+// absoluteURL resolve um endereço relativo (ex: /images/celular.jpg) a partir do endereço da loja;
+// o Google Merchant só aceita imagens com URL absoluta
+func absoluteURL(baseURL, ref string) string {
+	u, err := url.Parse(ref)
+	if ref == "" || err != nil || u.IsAbs() {
+		return ref
+	}
+	base, err := url.Parse(baseURL + "/")
+	if err != nil {
+		return ref
+	}
+	return base.ResolveReference(u).String()
+}
+
+// variantLabel descreve a variante pelos valores de cada eixo, na ordem dos eixos (ex: "P / Azul")
+func variantLabel(options []entities.ProductOption, variant entities.ProductVariant) string {
+	values := make([]string, 0, len(options))
+	for _, option := range options {
+		if value, ok := variant.Options[option.Name]; ok {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return variant.SKU
+	}
+	return strings.Join(values, " / ")
+}
+
+// formatFeedPrice formata o preço no padrão do Google Merchant (ex: "2999.99 BRL")
+func formatFeedPrice(price entities.Money) string {
+	return price.String() + " " + price.Currency
+}
+
+// feedAvailability converte o estoque na disponibilidade do Google Merchant
+func feedAvailability(quantity int64) string {
+	if quantity > 0 {
+		return "in_stock"
+	}
+	return "out_of_stock"
+}
+
+// feedTSVRecord converte um item nas colunas do TSV; tabulações e quebras de linha viram espaços
+func feedTSVRecord(item dto.FeedItem) []string {
+	clean := strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ")
+	values := []string{
+		item.ID, item.Title, item.Description, item.Link, item.ImageLink, item.Price, item.Availability,
+		item.ProductType, item.Condition, item.IdentifierExists, item.ItemGroupID,
+	}
+	for i, value := range values {
+		values[i] = clean.Replace(value)
+	}
+	return values
+}
@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"bufio"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportFileSize limita o tamanho do arquivo CSV importado (10 MB)
+const maxImportFileSize = 10 << 20
+
+// importColumns lista as colunas aceitas na planilha e se são obrigatórias
+var importColumns = map[string]bool{
+	"name":        true,
+	"price":       true,
+	"category":    true,
+	"sku":         false,
+	"slug":        false,
+	"description": false,
+	"image":       false,
+	"currency":    false,
+}
+
+// ignoredImportColumns lista colunas da exportação que a importação aceita e ignora,
+// permitindo reimportar um CSV exportado
+var ignoredImportColumns = map[string]bool{
+	"id":             true,
+	"category_id":    true,
+	"stock_quantity": true,
+	"attributes":     true,
+	"created_at":     true,
+	"updated_at":     true,
+}
+
+// ImportHandler gerencia os endpoints HTTP de importação
+type ImportHandler struct {
+	importUseCase usecases.ImportUseCase
+}
+
+// NewImportHandler cria uma nova instância de ImportHandler
+func NewImportHandler(importUseCase usecases.ImportUseCase) *ImportHandler {
+	return &ImportHandler{
+		importUseCase: importUseCase,
+	}
+}
+
+// ImportProducts importa produtos a partir de uma planilha CSV
+// @Summary Importar produtos de CSV
+// @Description Cria ou atualiza produtos a partir de um CSV com cabeçalho (name, price, category e, opcionalmente, sku, slug, description, image, currency), separado por vírgula ou ponto e vírgula. Produtos existentes são localizados pelo SKU ou pelo nome. Retorna um relatório linha a linha
+// @Tags imports
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Planilha CSV (UTF-8, até 10 MB)"
+// @Param dry_run query bool false "Validar e mostrar o resultado sem gravar"
+// @Param create_categories query bool false "Criar as categorias que não existirem"
+// @Success 200 {object} dto.ImportReportResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /imports/products [post]
+func (h *ImportHandler) ImportProducts(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportFileSize+1<<20)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.Error(usecases.NewValidationError("file_required", "Envie a planilha CSV no campo 'file'",
+			usecases.FieldError{Field: "file", Message: "é obrigatório"}))
+		return
+	}
+	if fileHeader.Size > maxImportFileSize {
+		c.Error(usecases.NewValidationError("file_too_large", "A planilha excede o limite de 10 MB",
+			usecases.FieldError{Field: "file", Message: "deve ter no máximo 10 MB"}))
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.Error(errUnreadableCSV)
+		return
+	}
+	defer file.Close()
+
+	rows, err := parseProductCSV(file)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	report, err := h.importUseCase.ImportProducts(c.Request.Context(), rows, usecases.ImportOptions{
+		DryRun:           c.Query("dry_run") == "true",
+		CreateCategories: c.Query("create_categories") == "true",
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := dto.ImportReportResponse{
+		DryRun:            report.DryRun,
+		Total:             len(report.Rows),
+		Created:           report.Created,
+		Updated:           report.Updated,
+		Invalid:           report.Invalid,
+		CategoriesCreated: report.CategoriesCreated,
+		Rows:              make([]dto.ImportRowResponse, len(report.Rows)),
+	}
+	for i, row := range report.Rows {
+		response.Rows[i] = dto.ImportRowResponse{
+			Line:      row.Line,
+			Status:    string(row.Status),
+			ProductID: row.ProductID,
+			SKU:       row.SKU,
+			Name:      row.Name,
+			Changed:   row.Changed,
+		}
+		if row.Err != nil {
+			response.Rows[i].Error = row.Err.Error()
+		}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// parseProductCSV lê a planilha, detectando o separador pelo cabeçalho
+func parseProductCSV(r io.Reader) ([]usecases.ImportRow, error) {
+	buffered := bufio.NewReader(r)
+
+	// Planilhas exportadas em português costumam usar ponto e vírgula
+	headerLine, err := buffered.Peek(buffered.Size())
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, errUnreadableCSV
+	}
+	if i := strings.IndexByte(string(headerLine), '\n'); i >= 0 {
+		headerLine = headerLine[:i]
+	}
+
+	reader := csv.NewReader(buffered)
+	if strings.Count(string(headerLine), ";") > strings.Count(string(headerLine), ",") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, invalidCSV("A planilha precisa de uma linha de cabeçalho")
+	}
+
+	columns := make(map[string]int, len(header))
+	var unknown []string
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if ignoredImportColumns[name] {
+			continue
+		}
+		if _, ok := importColumns[name]; !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		columns[name] = i
+	}
+	if len(unknown) > 0 {
+		return nil, invalidCSV(fmt.Sprintf("colunas desconhecidas: %s", strings.Join(unknown, ", ")))
+	}
+
+	var missing []string
+	for name, required := range importColumns {
+		if _, ok := columns[name]; required && !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, invalidCSV(fmt.Sprintf("colunas obrigatórias ausentes: %s", strings.Join(missing, ", ")))
+	}
+
+	var rows []usecases.ImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, invalidCSV(fmt.Sprintf("linha %d: CSV inválido", parseErr.Line))
+			}
+			return nil, invalidCSV("CSV inválido")
+		}
+		// A linha do arquivo onde o registro começa; campos entre aspas podem ocupar várias linhas
+		line, _ := reader.FieldPos(0)
+		if len(rows) >= usecases.MaxImportRows {
+			return nil, usecases.NewValidationError("import_too_large", fmt.Sprintf("a planilha excede o limite de %d linhas", usecases.MaxImportRows))
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+
+		// Ignorar linhas totalmente em branco
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		rows = append(rows, usecases.ImportRow{
+			Line:        line,
+			SKU:         field("sku"),
+			Slug:        field("slug"),
+			Name:        field("name"),
+			Description: field("description"),
+			Image:       field("image"),
+			Price:       field("price"),
+			Currency:    field("currency"),
+			Category:    field("category"),
+		})
+	}
+
+	return rows, nil
+}
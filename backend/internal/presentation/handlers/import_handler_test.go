@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseProductCSV(t *testing.T) {
+	tests := []struct {
+		name        string
+		csv         string
+		want        []usecases.ImportRow
+		wantCode    string
+		wantMessage string
+	}{
+		{
+			name: "vírgula",
+			csv:  "sku,name,price,category\nTEN-01,Tênis,199.90,Calçados\n",
+			want: []usecases.ImportRow{{Line: 2, SKU: "TEN-01", Name: "Tênis", Price: "199.90", Category: "Calçados"}},
+		},
+		{
+			name: "ponto e vírgula com vírgula decimal",
+			csv:  "name;price;category;currency\nCamiseta;49,90;Roupas;usd\n",
+			want: []usecases.ImportRow{{Line: 2, Name: "Camiseta", Price: "49,90", Category: "Roupas", Currency: "usd"}},
+		},
+		{
+			name: "BOM, maiúsculas e espaços no cabeçalho",
+			csv:  "\ufeffName, PRICE ,Category\nBoné,30,Acessórios\n",
+			want: []usecases.ImportRow{{Line: 2, Name: "Boné", Price: "30", Category: "Acessórios"}},
+		},
+		{
+			name: "colunas da exportação são ignoradas",
+			csv:  "id,sku,name,price,category,category_id,stock_quantity\n9,MEIA-1,Meia,9.90,Roupas,3,12\n",
+			want: []usecases.ImportRow{{Line: 2, SKU: "MEIA-1", Name: "Meia", Price: "9.90", Category: "Roupas"}},
+		},
+		{
+			name: "linhas em branco são puladas sem perder a numeração",
+			csv:  "name,price,category\n\nA,1,X\n,,\nB,2,X\n",
+			want: []usecases.ImportRow{
+				{Line: 3, Name: "A", Price: "1", Category: "X"},
+				{Line: 5, Name: "B", Price: "2", Category: "X"},
+			},
+		},
+		{
+			name: "campo em várias linhas",
+			csv:  "name,description,price,category\nA,\"linha 1\nlinha 2\",1,X\nB,,2,X\n",
+			want: []usecases.ImportRow{
+				{Line: 2, Name: "A", Description: "linha 1\nlinha 2", Price: "1", Category: "X"},
+				{Line: 4, Name: "B", Price: "2", Category: "X"},
+			},
+		},
+		{
+			name: "registro com menos campos",
+			csv:  "name,price,category,image\nA,1,X\n",
+			want: []usecases.ImportRow{{Line: 2, Name: "A", Price: "1", Category: "X"}},
+		},
+		{name: "vazio", csv: "", wantCode: "invalid_csv", wantMessage: "A planilha precisa de uma linha de cabeçalho"},
+		{name: "coluna desconhecida", csv: "name,price,category,peso\n", wantCode: "invalid_csv", wantMessage: "colunas desconhecidas: peso"},
+		{name: "colunas obrigatórias ausentes", csv: "sku,name\n", wantCode: "invalid_csv", wantMessage: "colunas obrigatórias ausentes: category, price"},
+		{name: "aspas inválidas", csv: "name,price,category\nA,1,X\nB,\"2\"x,X\n", wantCode: "invalid_csv", wantMessage: "linha 3: CSV inválido"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseProductCSV(strings.NewReader(tt.csv))
+			if tt.wantCode != "" {
+				var ucErr *usecases.Error
+				if !errors.As(err, &ucErr) || ucErr.Code != tt.wantCode || ucErr.Message != tt.wantMessage {
+					t.Fatalf("erro = %v, esperado %s: %s", err, tt.wantCode, tt.wantMessage)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseProductCSV retornou erro: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseProductCSV = %+v, esperado %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseProductCSVRowLimit(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("name,price,category\n")
+	for i := 0; i <= usecases.MaxImportRows; i++ {
+		b.WriteString("A,1,X\n")
+	}
+
+	_, err := parseProductCSV(strings.NewReader(b.String()))
+	var ucErr *usecases.Error
+	if !errors.As(err, &ucErr) || ucErr.Code != "import_too_large" {
+		t.Fatalf("erro = %v, esperado import_too_large", err)
+	}
+}
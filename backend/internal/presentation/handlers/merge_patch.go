@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errMalformedPatch indica um corpo de JSON Merge Patch que não é um objeto JSON
+var errMalformedPatch = usecases.NewBadRequestError("malformed_request", "Dados inválidos: o corpo deve ser um objeto JSON")
+
+// mergePatch representa o corpo de uma requisição JSON Merge Patch (RFC 7396):
+// campos ausentes não são alterados e campos null são removidos
+type mergePatch map[string]json.RawMessage
+
+// bindMergePatch lê o corpo da requisição, rejeitando campos fora da lista permitida
+func bindMergePatch(c *gin.Context, allowed ...string) (mergePatch, error) {
+	var data json.RawMessage
+	if err := json.NewDecoder(c.Request.Body).Decode(&data); err != nil {
+		return nil, errMalformedPatch
+	}
+	return parseMergePatch(data, allowed...)
+}
+
+// parseMergePatch decodifica um documento JSON Merge Patch, rejeitando campos fora da lista permitida
+func parseMergePatch(data []byte, allowed ...string) (mergePatch, error) {
+	var patch mergePatch
+	if err := json.Unmarshal(data, &patch); err != nil || patch == nil {
+		return nil, errMalformedPatch
+	}
+
+	known := make(map[string]bool, len(allowed))
+	for _, field := range allowed {
+		known[field] = true
+	}
+	var unknown []string
+	for field := range patch {
+		if !known[field] {
+			unknown = append(unknown, field)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		fields := make([]usecases.FieldError, len(unknown))
+		for i, field := range unknown {
+			fields[i] = usecases.FieldError{Field: field, Message: "campo desconhecido"}
+		}
+		return nil, usecases.NewValidationError("unknown_fields",
+			fmt.Sprintf("campos desconhecidos: %s", strings.Join(unknown, ", ")), fields...)
+	}
+
+	return patch, nil
+}
+
+// isNull indica se o campo foi enviado com valor null
+func (p mergePatch) isNull(field string) bool {
+	raw, ok := p[field]
+	return ok && string(raw) == "null"
+}
+
+// string decodifica um campo de texto; null é tratado como texto vazio
+func (p mergePatch) string(field string) (*string, error) {
+	raw, ok := p[field]
+	if !ok {
+		return nil, nil
+	}
+
+	value := ""
+	if !p.isNull(field) {
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, invalidBodyField(field, field+" deve ser um texto")
+		}
+	}
+	return &value, nil
+}
+
+// number decodifica um campo numérico enviado como número ou string; null não é aceito
+func (p mergePatch) number(field string) (*string, error) {
+	raw, ok := p[field]
+	if !ok {
+		return nil, nil
+	}
+
+	var value json.Number
+	if p.isNull(field) || json.Unmarshal(raw, &value) != nil {
+		return nil, invalidBodyField(field, field+" deve ser um número")
+	}
+	text := value.String()
+	return &text, nil
+}
+
+// id decodifica um campo de ID; null é retornado como ponteiro nil com ok verdadeiro
+func (p mergePatch) id(field string) (value *uint, ok bool, err error) {
+	raw, ok := p[field]
+	if !ok || p.isNull(field) {
+		return nil, ok, nil
+	}
+
+	var id uint
+	if err := json.Unmarshal(raw, &id); err != nil || id == 0 {
+		return nil, true, invalidBodyField(field, field+" deve ser um ID válido")
+	}
+	return &id, true, nil
+}
+
+// object decodifica um campo objeto; null é tratado como objeto vazio
+func (p mergePatch) object(field string) (map[string]interface{}, error) {
+	raw, ok := p[field]
+	if !ok || p.isNull(field) {
+		return nil, nil
+	}
+
+	var value map[string]interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, invalidBodyField(field, field+" deve ser um objeto")
+	}
+	return value, nil
+}
+
+// money decodifica um campo monetário da API v2 ({"amount": "29.90", "currency": "BRL"}), que aceita
+// apenas amount, apenas currency ou ambos; null e amount numérico não são aceitos
+func (p mergePatch) money(field string) (amount, currency *string, err error) {
+	raw, ok := p[field]
+	if !ok {
+		return nil, nil, nil
+	}
+
+	var value struct {
+		Amount   *string `json:"amount"`
+		Currency *string `json:"currency"`
+	}
+	if p.isNull(field) || json.Unmarshal(raw, &value) != nil || (value.Amount == nil && value.Currency == nil) {
+		return nil, nil, invalidBodyField(field, field+` deve ser um objeto com amount em texto (ex: {"amount": "29.90"})`)
+	}
+	return value.Amount, value.Currency, nil
+}
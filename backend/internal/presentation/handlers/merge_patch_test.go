@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseMergePatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantFields []string
+		wantCode   string
+	}{
+		{name: "objeto vazio", data: `{}`, wantFields: []string{}},
+		{name: "campos permitidos", data: `{"name": "Novo", "price": null}`, wantFields: []string{"name", "price"}},
+		{name: "null no documento", data: `null`, wantCode: "malformed_request"},
+		{name: "lista", data: `[{"name": "Novo"}]`, wantCode: "malformed_request"},
+		{name: "texto", data: `"name"`, wantCode: "malformed_request"},
+		{name: "JSON inválido", data: `{"name": `, wantCode: "malformed_request"},
+		{name: "campo desconhecido", data: `{"name": "Novo", "stock": 3, "id": 1}`, wantCode: "unknown_fields"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := parseMergePatch([]byte(tt.data), "name", "price")
+			if tt.wantCode != "" {
+				var ucErr *usecases.Error
+				if !errors.As(err, &ucErr) || ucErr.Code != tt.wantCode {
+					t.Fatalf("erro = %v, esperado %s", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseMergePatch(%s) retornou erro: %v", tt.data, err)
+			}
+			for _, field := range tt.wantFields {
+				if _, ok := patch[field]; !ok {
+					t.Errorf("campo %s ausente do patch", field)
+				}
+			}
+			if len(patch) != len(tt.wantFields) {
+				t.Errorf("patch com %d campos, esperado %d", len(patch), len(tt.wantFields))
+			}
+		})
+	}
+}
+
+func TestParseMergePatchUnknownFields(t *testing.T) {
+	_, err := parseMergePatch([]byte(`{"stock": 3, "name": "x", "id": 1}`), "name")
+
+	var ucErr *usecases.Error
+	if !errors.As(err, &ucErr) {
+		t.Fatalf("erro = %v, esperado erro de validação", err)
+	}
+	want := []usecases.FieldError{
+		{Field: "id", Message: "campo desconhecido"},
+		{Field: "stock", Message: "campo desconhecido"},
+	}
+	if !reflect.DeepEqual(ucErr.Fields, want) {
+		t.Errorf("Fields = %+v, esperado %+v", ucErr.Fields, want)
+	}
+}
+
+func TestProductPatchFrom(t *testing.T) {
+	text := func(s string) *string { return &s }
+	id := func(v uint) *uint { return &v }
+
+	tests := []struct {
+		name      string
+		data      string
+		want      usecases.ProductPatch
+		wantField string
+	}{
+		{name: "vazio não altera nada", data: `{}`, want: usecases.ProductPatch{}},
+		{
+			name: "textos",
+			data: `{"name": "Tênis", "sku": "TEN-01", "slug": "tenis"}`,
+			want: usecases.ProductPatch{Name: text("Tênis"), SKU: text("TEN-01"), Slug: text("tenis")},
+		},
+		{
+			name: "null limpa textos",
+			data: `{"description": null, "image": null}`,
+			want: usecases.ProductPatch{Description: text(""), Image: text("")},
+		},
+		{
+			name: "preço como número mantém o decimal exato",
+			data: `{"price": 19.90}`,
+			want: usecases.ProductPatch{Price: text("19.90")},
+		},
+		{
+			name: "preço como texto e moeda",
+			data: `{"price": "2999.99", "currency": "USD"}`,
+			want: usecases.ProductPatch{Price: text("2999.99"), Currency: text("USD")},
+		},
+		{name: "categoria", data: `{"category_id": 7}`, want: usecases.ProductPatch{CategoryID: id(7)}},
+		{
+			name: "atributos são mesclados",
+			data: `{"attributes": {"ram_gb": 16, "cor": null}}`,
+			want: usecases.ProductPatch{Attributes: map[string]interface{}{"ram_gb": float64(16), "cor": nil}},
+		},
+		{name: "null remove todos os atributos", data: `{"attributes": null}`, want: usecases.ProductPatch{ClearAttributes: true}},
+		{name: "preço null", data: `{"price": null}`, wantField: "price"},
+		{name: "preço não numérico", data: `{"price": true}`, wantField: "price"},
+		{name: "nome numérico", data: `{"name": 10}`, wantField: "name"},
+		{name: "categoria null", data: `{"category_id": null}`, wantField: "category_id"},
+		{name: "categoria zero", data: `{"category_id": 0}`, wantField: "category_id"},
+		{name: "categoria negativa", data: `{"category_id": -1}`, wantField: "category_id"},
+		{name: "atributos em lista", data: `{"attributes": ["ram_gb"]}`, wantField: "attributes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := parseMergePatch([]byte(tt.data), productPatchFields...)
+			if err != nil {
+				t.Fatalf("parseMergePatch(%s) retornou erro: %v", tt.data, err)
+			}
+
+			got, err := productPatchFrom(body)
+			if tt.wantField != "" {
+				var ucErr *usecases.Error
+				if !errors.As(err, &ucErr) || ucErr.Fields[0].Field != tt.wantField {
+					t.Fatalf("erro = %#v, esperado erro no campo %s", err, tt.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("productPatchFrom(%s) retornou erro: %v", tt.data, err)
+			}
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("productPatchFrom(%s) = %+v, esperado %+v", tt.data, *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"catalogo-produtos/backend/internal/presentation/middleware"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// BulkProducts executa um lote de operações de produtos
+// @Summary Operações em lote
+// @Description Executa criações, atualizações parciais (JSON Merge Patch) e remoções de produtos em ordem, retornando o resultado de cada operação. Com atomic=true, o lote roda em uma única transação e nada é gravado se alguma operação falhar
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param atomic query bool false "Executar o lote em uma única transação"
+// @Param operations body dto.BulkRequest true "Operações do lote (máximo 500)"
+// @Success 200 {object} dto.BulkResponse
+// @Success 207 {object} dto.BulkResponse "Uma ou mais operações falharam"
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /products/bulk [post]
+func (h *ProductHandler) BulkProducts(c *gin.Context) {
+	atomic := c.Query("atomic") == "true"
+
+	var req dto.BulkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos: informe de 1 a 500 operações com action e id"))
+		return
+	}
+
+	operations := make([]usecases.BulkOperation, len(req.Operations))
+	for i, item := range req.Operations {
+		operations[i] = parseBulkOperation(item)
+	}
+
+	results, err := h.productUseCase.ExecuteBulk(c.Request.Context(), operations, atomic)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	response := dto.BulkResponse{Atomic: atomic, Results: make([]dto.BulkResultResponse, len(results))}
+	for i, result := range results {
+		item := dto.BulkResultResponse{
+			Index:   result.Index,
+			Action:  string(result.Action),
+			ID:      result.ID,
+			Status:  bulkStatus(result),
+			Changed: result.Changed,
+		}
+		if result.Err != nil {
+			problem := middleware.ProblemFor(result.Err)
+			item.Error = &problem
+			response.Failed++
+		} else {
+			response.Succeeded++
+		}
+		if result.Product != nil {
+			product := h.mapToProductResponse(*result.Product)
+			item.Data = &product
+		}
+		response.Results[i] = item
+	}
+
+	status := http.StatusOK
+	if response.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	c.JSON(status, response)
+}
+
+// parseBulkOperation converte uma operação da requisição; entradas inválidas são registradas em Err
+func parseBulkOperation(item dto.BulkOperationRequest) usecases.BulkOperation {
+	operation := usecases.BulkOperation{Action: usecases.BulkAction(item.Action), ID: item.ID, Version: item.Version}
+
+	switch operation.Action {
+	case usecases.BulkCreate:
+		if len(item.Data) == 0 || string(item.Data) == "null" {
+			operation.Err = invalidBodyField("data", "data é obrigatório")
+			return operation
+		}
+		var req dto.ProductCreateRequest
+		if err := json.Unmarshal(item.Data, &req); err != nil {
+			operation.Err = bindingError(err, "Dados do produto inválidos")
+			return operation
+		}
+		if err := binding.Validator.ValidateStruct(&req); err != nil {
+			operation.Err = bindingError(err, "Dados do produto inválidos")
+			return operation
+		}
+		price, err := entities.ParseMoney(req.Price.String(), req.Currency)
+		if err != nil {
+			operation.Err = invalidPrice(err)
+			return operation
+		}
+		operation.Input = usecases.ProductInput{
+			Name:        req.Name,
+			Image:       req.Image,
+			Description: req.Description,
+			Price:       price,
+			CategoryID:  req.CategoryID,
+			SKU:         req.SKU,
+			Slug:        req.Slug,
+			Attributes:  req.Attributes,
+		}
+
+	case usecases.BulkUpdate:
+		body, err := parseMergePatch(item.Data, productPatchFields...)
+		if err != nil {
+			operation.Err = err
+			return operation
+		}
+		patch, err := productPatchFrom(body)
+		if err != nil {
+			operation.Err = err
+			return operation
+		}
+		operation.Patch = *patch
+	}
+
+	return operation
+}
+
+// bulkStatus retorna o código HTTP equivalente ao resultado de uma operação do lote
+func bulkStatus(result usecases.BulkResult) int {
+	switch {
+	case result.Err == nil && result.Action == usecases.BulkCreate:
+		return http.StatusCreated
+	case result.Err == nil:
+		return http.StatusOK
+	default:
+		return middleware.StatusFor(result.Err)
+	}
+}
@@ -5,8 +5,15 @@ import (
 	"catalogo-produtos/backend/internal/domain/repositories"
 	"catalogo-produtos/backend/internal/domain/usecases"
 	"catalogo-produtos/backend/internal/presentation/dto"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -26,32 +33,39 @@ func NewProductHandler(productUseCase usecases.ProductUseCase) *ProductHandler {
 
 // GetProducts retorna todos os produtos com filtros opcionais
 // @Summary Listar produtos
-// @Description Retorna todos os produtos com filtros opcionais por nome e categoria
+// @Description Retorna os produtos paginados com filtros opcionais por nome e categoria
 // @Tags products
 // @Accept json
 // @Produce json
+// @Param q query string false "Busca textual por nome e descrição, ordenada por relevância"
 // @Param name query string false "Filtrar por nome do produto"
 // @Param category query string false "Filtrar por nome da categoria"
+// @Param category_id query []int false "Filtrar por ID da categoria (repetível)" collectionFormat(multi)
+// @Param include_subcategories query bool false "Incluir produtos das subcategorias de category_id"
+// @Param currency query string false "Filtrar pela moeda; também é a moeda de min_price e max_price (padrão BRL)"
+// @Param min_price query number false "Preço mínimo"
+// @Param max_price query number false "Preço máximo"
+// @Param created_after query string false "Criados a partir de (YYYY-MM-DD ou RFC3339)"
+// @Param updated_after query string false "Atualizados a partir de (YYYY-MM-DD ou RFC3339)"
+// @Param has_image query bool false "Filtrar produtos com ou sem imagem"
+// @Param page query int false "Número da página (padrão 1)"
+// @Param page_size query int false "Itens por página (padrão 20, máximo 100)"
+// @Param sort query string false "Ordenação, ex: price,-created_at"
+// @Param attr.{key} query string false "Filtrar por atributo, ex: attr.ram_gb>=8 ou attr.cor=preto (operadores =, !=, >, >=, <, <=)"
 // @Success 200 {object} dto.ProductsResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
 // @Router /products [get]
 func (h *ProductHandler) GetProducts(c *gin.Context) {
-	var filterReq dto.ProductFilterRequest
-	if err := c.ShouldBindQuery(&filterReq); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Parâmetros de filtro inválidos"})
+	filters, err := bindProductFilter(c)
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
-	// Converter DTO para domínio
-	filters := &repositories.ProductFilter{
-		Name:     filterReq.Name,
-		Category: filterReq.Category,
-	}
-
-	products, err := h.productUseCase.GetProducts(filters)
+	products, total, err := h.productUseCase.GetProducts(filters)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Erro ao buscar produtos"})
+		c.Error(err)
 		return
 	}
 
@@ -61,9 +75,15 @@ func (h *ProductHandler) GetProducts(c *gin.Context) {
 		productResponses[i] = h.mapToProductResponse(product)
 	}
 
+	totalPages := int((total + int64(filters.PageSize) - 1) / int64(filters.PageSize))
+
 	c.JSON(http.StatusOK, dto.ProductsResponse{
-		Data:  productResponses,
-		Total: len(productResponses),
+		Data:       productResponses,
+		Total:      total,
+		Page:       filters.Page,
+		PageSize:   filters.PageSize,
+		TotalPages: totalPages,
+		Links:      buildPaginationLinks(c.Request.URL, filters.Page, totalPages),
 	})
 }
 
@@ -74,7 +94,9 @@ func (h *ProductHandler) GetProducts(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID do produto"
+// @Param If-None-Match header string false "ETag já conhecida pelo cliente"
 // @Success 200 {object} dto.SingleProductResponse
+// @Success 304 "Produto não alterado desde a ETag informada"
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 404 {object} dto.ErrorResponse
 // @Router /products/{id} [get]
@@ -82,13 +104,66 @@ func (h *ProductHandler) GetProduct(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID inválido"})
+		c.Error(errInvalidID)
 		return
 	}
 
 	product, err := h.productUseCase.GetProduct(uint(id))
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Produto não encontrado"})
+		c.Error(err)
+		return
+	}
+	if writeETag(c, productTag(*product)) {
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SingleProductResponse{
+		Data: h.mapToProductResponse(*product),
+	})
+}
+
+// GetProductBySKU retorna um produto pelo SKU
+// @Summary Buscar produto por SKU
+// @Description Retorna um produto específico pelo SKU
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param sku path string true "SKU do produto"
+// @Success 200 {object} dto.SingleProductResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /products/by-sku/{sku} [get]
+func (h *ProductHandler) GetProductBySKU(c *gin.Context) {
+	product, err := h.productUseCase.GetProductBySKU(c.Param("sku"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	if writeETag(c, productTag(*product)) {
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SingleProductResponse{
+		Data: h.mapToProductResponse(*product),
+	})
+}
+
+// GetProductBySlug retorna um produto pelo slug
+// @Summary Buscar produto por slug
+// @Description Retorna um produto específico pelo slug de URL
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param slug path string true "Slug do produto"
+// @Success 200 {object} dto.SingleProductResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /products/by-slug/{slug} [get]
+func (h *ProductHandler) GetProductBySlug(c *gin.Context) {
+	product, err := h.productUseCase.GetProductBySlug(c.Param("slug"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	if writeETag(c, productTag(*product)) {
 		return
 	}
 
@@ -106,17 +181,45 @@ func (h *ProductHandler) GetProduct(c *gin.Context) {
 // @Param product body dto.ProductCreateRequest true "Dados do produto"
 // @Success 201 {object} dto.SingleProductResponse
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
 // @Router /products [post]
 func (h *ProductHandler) CreateProduct(c *gin.Context) {
 	var req dto.ProductCreateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Dados inválidos"})
+		c.Error(bindingError(err, "Dados inválidos"))
 		return
 	}
 
-	product, err := h.productUseCase.CreateProduct(req.Name, req.Image, req.Description, req.Price, req.CategoryID)
+	price, err := entities.ParseMoney(req.Price.String(), req.Currency)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		c.Error(invalidPrice(err))
+		return
+	}
+
+	if req.SKU == "" {
+		if req.SKU, err = legacySKU(); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	product, err := h.productUseCase.CreateProduct(c.Request.Context(), usecases.ProductInput{
+		Name:        req.Name,
+		Image:       req.Image,
+		Description: req.Description,
+		Price:       price,
+		CategoryID:  req.CategoryID,
+		SKU:         req.SKU,
+		Slug:        req.Slug,
+		Attributes:  req.Attributes,
+	})
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -132,35 +235,176 @@ func (h *ProductHandler) CreateProduct(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID do produto"
+// @Param If-Match header string true "ETag obtida na leitura do produto, ou * para ignorar a versão"
 // @Param product body dto.ProductUpdateRequest true "Dados do produto"
 // @Success 200 {object} dto.SingleProductResponse
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 412 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Failure 428 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
 // @Router /products/{id} [put]
 func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID inválido"})
+		c.Error(errInvalidID)
+		return
+	}
+
+	version, ok := requireIfMatch(c)
+	if !ok {
 		return
 	}
 
 	var req dto.ProductUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Dados inválidos"})
+		c.Error(bindingError(err, "Dados inválidos"))
 		return
 	}
 
-	product, err := h.productUseCase.UpdateProduct(uint(id), req.Name, req.Image, req.Description, req.Price, req.CategoryID)
+	price, err := entities.ParseMoney(req.Price.String(), req.Currency)
+	if err != nil {
+		c.Error(invalidPrice(err))
+		return
+	}
+
+	product, err := h.productUseCase.UpdateProduct(c.Request.Context(), uint(id), usecases.ProductInput{
+		Name:        req.Name,
+		Image:       req.Image,
+		Description: req.Description,
+		Price:       price,
+		CategoryID:  req.CategoryID,
+		SKU:         req.SKU,
+		Slug:        req.Slug,
+		Attributes:  req.Attributes,
+		Version:     version,
+	})
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 
+	c.Header("ETag", productTag(*product))
 	c.JSON(http.StatusOK, dto.SingleProductResponse{
 		Data: h.mapToProductResponse(*product),
 	})
 }
 
+// PatchProduct atualiza parcialmente um produto
+// @Summary Atualizar produto parcialmente
+// @Description Aplica um JSON Merge Patch (RFC 7396): apenas os campos enviados são alterados e null remove o valor. Retorna os campos efetivamente alterados
+// @Tags products
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Param If-Match header string true "ETag obtida na leitura do produto, ou * para ignorar a versão"
+// @Param product body dto.ProductPatchRequest true "Campos a alterar"
+// @Success 200 {object} dto.PatchProductResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 412 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Failure 428 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /products/{id} [patch]
+func (h *ProductHandler) PatchProduct(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	version, ok := requireIfMatch(c)
+	if !ok {
+		return
+	}
+
+	patch, err := h.bindProductPatch(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	patch.Version = version
+
+	product, changed, err := h.productUseCase.PatchProduct(c.Request.Context(), uint(id), *patch)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("ETag", productTag(*product))
+	c.JSON(http.StatusOK, dto.PatchProductResponse{
+		Data:    h.mapToProductResponse(*product),
+		Changed: changed,
+	})
+}
+
+// productPatchFields lista os campos aceitos em um JSON Merge Patch de produto
+var productPatchFields = []string{"sku", "slug", "name", "image", "price", "currency", "category_id", "description", "attributes"}
+
+// bindProductPatch converte o JSON Merge Patch da requisição em uma atualização parcial
+func (h *ProductHandler) bindProductPatch(c *gin.Context) (*usecases.ProductPatch, error) {
+	body, err := bindMergePatch(c, productPatchFields...)
+	if err != nil {
+		return nil, err
+	}
+	return productPatchFrom(body)
+}
+
+// productPatchFrom converte um JSON Merge Patch já decodificado em uma atualização parcial
+func productPatchFrom(body mergePatch) (*usecases.ProductPatch, error) {
+	var err error
+	var patch usecases.ProductPatch
+	if patch.SKU, err = body.string("sku"); err != nil {
+		return nil, err
+	}
+	if patch.Slug, err = body.string("slug"); err != nil {
+		return nil, err
+	}
+	if patch.Name, err = body.string("name"); err != nil {
+		return nil, err
+	}
+	if patch.Image, err = body.string("image"); err != nil {
+		return nil, err
+	}
+	if patch.Description, err = body.string("description"); err != nil {
+		return nil, err
+	}
+	if patch.Currency, err = body.string("currency"); err != nil {
+		return nil, err
+	}
+	if patch.Price, err = body.number("price"); err != nil {
+		return nil, err
+	}
+
+	categoryID, sent, err := body.id("category_id")
+	if err != nil {
+		return nil, err
+	}
+	if sent && categoryID == nil {
+		return nil, invalidBodyField("category_id", "category_id não pode ser removido")
+	}
+	patch.CategoryID = categoryID
+
+	if patch.Attributes, err = body.object("attributes"); err != nil {
+		return nil, err
+	}
+	patch.ClearAttributes = body.isNull("attributes")
+
+	return &patch, nil
+}
+
 // DeleteProduct remove um produto
 // @Summary Deletar produto
 // @Description Remove um produto
@@ -168,20 +412,32 @@ func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "ID do produto"
+// @Param If-Match header string true "ETag obtida na leitura do produto, ou * para ignorar a versão"
 // @Success 200 {object} dto.MessageResponse
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 412 {object} dto.ErrorResponse
+// @Failure 428 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
 // @Router /products/{id} [delete]
 func (h *ProductHandler) DeleteProduct(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "ID inválido"})
+		c.Error(errInvalidID)
+		return
+	}
+
+	version, ok := requireIfMatch(c)
+	if !ok {
 		return
 	}
 
-	err = h.productUseCase.DeleteProduct(uint(id))
+	err = h.productUseCase.DeleteProduct(c.Request.Context(), uint(id), version)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -190,20 +446,251 @@ func (h *ProductHandler) DeleteProduct(c *gin.Context) {
 
 // mapToProductResponse converte entidade para DTO de resposta
 func (h *ProductHandler) mapToProductResponse(product entities.Product) dto.ProductResponse {
-	return dto.ProductResponse{
+	response := dto.ProductResponse{
 		ID:         product.ID,
+		SKU:        product.SKU,
+		Slug:       product.Slug,
 		Name:       product.Name,
 		Image:      product.Image,
-		Price:      product.Price,
+		Price:      json.Number(product.Price.String()),
+		Currency:   product.Price.Currency,
 		CategoryID: product.CategoryID,
 		Category: dto.CategoryResponse{
 			ID:        product.Category.ID,
 			Name:      product.Category.Name,
+			ParentID:  product.Category.ParentID,
+			Version:   product.Category.Version,
 			CreatedAt: product.Category.CreatedAt.Format(time.RFC3339),
 			UpdatedAt: product.Category.UpdatedAt.Format(time.RFC3339),
 		},
-		Description: product.Description,
-		CreatedAt:   product.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   product.UpdatedAt.Format(time.RFC3339),
+		Description:       product.Description,
+		Attributes:        product.Attributes,
+		InStock:           availableQuantity(product) > 0,
+		AvailableQuantity: availableQuantity(product),
+		Options:           mapToOptionResponses(product.Options),
+		Variants:          mapToVariantResponses(product),
+		Version:           product.Version,
+		CreatedAt:         product.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         product.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if product.Highlight != nil {
+		response.Highlight = &dto.HighlightResponse{
+			Name:        product.Highlight.Name,
+			Description: product.Highlight.Description,
+			Rank:        product.SearchRank,
+		}
+	}
+
+	return response
+}
+
+// availableQuantity retorna o estoque disponível: a soma das variantes, se houver, ou o do próprio produto
+func availableQuantity(product entities.Product) int64 {
+	if len(product.Variants) == 0 {
+		return product.StockQuantity
+	}
+
+	var total int64
+	for _, variant := range product.Variants {
+		total += variant.StockQuantity
+	}
+	return total
+}
+
+// bindProductFilter lê e valida os parâmetros de filtro da listagem
+func bindProductFilter(c *gin.Context) (*repositories.ProductFilter, error) {
+	var filterReq dto.ProductFilterRequest
+	if err := c.ShouldBindQuery(&filterReq); err != nil {
+		return nil, bindingError(err, "Parâmetros de filtro inválidos")
+	}
+
+	// Os filtros de preço são interpretados na moeda filtrada, ou na moeda padrão
+	currency := strings.ToUpper(filterReq.Currency)
+	minPrice, err := parsePriceParam("min_price", filterReq.MinPrice, currency)
+	if err != nil {
+		return nil, err
+	}
+	maxPrice, err := parsePriceParam("max_price", filterReq.MaxPrice, currency)
+	if err != nil {
+		return nil, err
+	}
+	if minPrice != nil && maxPrice != nil && minPrice.Amount > maxPrice.Amount {
+		return nil, invalidParam("min_price", "min_price não pode ser maior que max_price")
+	}
+
+	createdAfter, err := parseTimeParam("created_after", filterReq.CreatedAfter)
+	if err != nil {
+		return nil, err
+	}
+	updatedAfter, err := parseTimeParam("updated_after", filterReq.UpdatedAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	sort, err := parseSort(filterReq.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := parseAttributeFilters(c.Request.URL.RawQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	// Converter DTO para domínio
+	return &repositories.ProductFilter{
+		Query:                strings.TrimSpace(filterReq.Q),
+		Name:                 filterReq.Name,
+		Category:             filterReq.Category,
+		CategoryIDs:          filterReq.CategoryIDs,
+		IncludeSubcategories: filterReq.IncludeSubcategories,
+		Currency:             currency,
+		MinPrice:             minPrice,
+		MaxPrice:             maxPrice,
+		CreatedAfter:         createdAfter,
+		UpdatedAfter:         updatedAfter,
+		HasImage:             filterReq.HasImage,
+		Attributes:           attributes,
+		Page:                 filterReq.Page,
+		PageSize:             filterReq.PageSize,
+		Sort:                 sort,
+	}, nil
+}
+
+// parsePriceParam converte um parâmetro de preço decimal não negativo na moeda (padrão BRL)
+func parsePriceParam(name, value, currency string) (*entities.Money, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	price, err := entities.ParseMoney(value, currency)
+	if err != nil || price.Amount < 0 {
+		return nil, invalidParam(name, fmt.Sprintf("%s inválido: informe um valor não negativo com as casas decimais da moeda", name))
+	}
+
+	return &price, nil
+}
+
+// parseTimeParam converte um parâmetro de data (YYYY-MM-DD ou RFC3339)
+func parseTimeParam(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t, nil
+		}
+	}
+
+	return nil, invalidParam(name, fmt.Sprintf("%s inválido: use o formato YYYY-MM-DD ou RFC3339", name))
+}
+
+// parseSort converte o parâmetro sort (ex: "price,-created_at") em campos de ordenação
+func parseSort(value string) ([]repositories.SortField, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var fields []repositories.SortField
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		field := repositories.SortField{Field: part}
+		if strings.HasPrefix(part, "-") {
+			field = repositories.SortField{Field: part[1:], Desc: true}
+		}
+
+		if !repositories.ProductSortFields[field.Field] {
+			return nil, invalidParam("sort", fmt.Sprintf("campo de ordenação inválido: %s", field.Field))
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// legacySKU gera o SKU de um produto criado pela v1 sem SKU, que era opcional no contrato original
+func legacySKU() (string, error) {
+	buf := make([]byte, 4)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "PRD-" + strings.ToUpper(hex.EncodeToString(buf)), nil
+}
+
+// attributeFilterPattern reconhece filtros por atributo (ex: attr.ram_gb>=8)
+var attributeFilterPattern = regexp.MustCompile(`^attr\.([a-z0-9_]+)(>=|<=|!=|>|<|=)(.*)$`)
+
+// parseAttributeFilters extrai os filtros attr.<chave><operador><valor> da query string.
+// A query bruta é usada porque operadores como ">=" não formam pares chave=valor.
+func parseAttributeFilters(rawQuery string) ([]repositories.AttributeFilter, error) {
+	var filters []repositories.AttributeFilter
+	for _, part := range strings.Split(rawQuery, "&") {
+		if !strings.HasPrefix(part, "attr.") && !strings.HasPrefix(part, "attr%2E") {
+			continue
+		}
+
+		// Como em um caminho, "+" é mantido: o valor pode ser, por exemplo, "c++"
+		decoded, err := url.PathUnescape(part)
+		if err != nil {
+			return nil, invalidParam("attr", fmt.Sprintf("filtro de atributo inválido: %s", part))
+		}
+
+		match := attributeFilterPattern.FindStringSubmatch(decoded)
+		if match == nil || match[3] == "" {
+			return nil, invalidParam("attr", fmt.Sprintf("filtro de atributo inválido: %s", decoded))
+		}
+
+		filter := repositories.AttributeFilter{Key: match[1], Operator: match[2], Value: match[3]}
+		switch filter.Operator {
+		case repositories.AttributeOpEqual, repositories.AttributeOpNotEqual:
+		default:
+			if _, err := strconv.ParseFloat(filter.Value, 64); err != nil {
+				return nil, invalidParam("attr."+filter.Key, fmt.Sprintf("filtro attr.%s: o operador %s exige um valor numérico", filter.Key, filter.Operator))
+			}
+		}
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// buildPaginationLinks monta os links de navegação a partir da URL da requisição. Apenas o
+// parâmetro page é substituído: os demais seguem como vieram, já que filtros como attr.ram_gb>=8
+// não sobrevivem à recodificação em pares chave=valor.
+func buildPaginationLinks(requestURL *url.URL, page, totalPages int) dto.PaginationLinks {
+	var params []string
+	for _, part := range strings.Split(requestURL.RawQuery, "&") {
+		key, _, _ := strings.Cut(part, "=")
+		if part == "" || key == "page" {
+			continue
+		}
+		params = append(params, part)
+	}
+
+	pageURL := func(p int) string {
+		u := *requestURL
+		u.RawQuery = strings.Join(append(params[:len(params):len(params)], "page="+strconv.Itoa(p)), "&")
+		return u.RequestURI()
+	}
+
+	links := dto.PaginationLinks{Self: pageURL(page)}
+	if page < totalPages {
+		links.Next = pageURL(page + 1)
+	}
+	switch {
+	case page > totalPages:
+		// Além da última página, prev aponta para a última, e não para a página inexistente anterior
+		if totalPages >= 1 {
+			links.Prev = pageURL(totalPages)
+		}
+	case page > 1:
+		links.Prev = pageURL(page - 1)
 	}
+	return links
 }
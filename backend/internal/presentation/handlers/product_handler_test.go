@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseAttributeFilters(t *testing.T) {
+	tests := []struct {
+		name      string
+		rawQuery  string
+		want      []repositories.AttributeFilter
+		wantField string
+	}{
+		{name: "sem filtros", rawQuery: "page=2&category_id=4"},
+		{
+			name:     "igualdade",
+			rawQuery: "attr.armazenamento=ssd",
+			want:     []repositories.AttributeFilter{{Key: "armazenamento", Operator: "=", Value: "ssd"}},
+		},
+		{
+			name:     "operadores numéricos",
+			rawQuery: "attr.ram_gb>=8&attr.peso<2.5&attr.tela>13&attr.preco<=100",
+			want: []repositories.AttributeFilter{
+				{Key: "ram_gb", Operator: ">=", Value: "8"},
+				{Key: "peso", Operator: "<", Value: "2.5"},
+				{Key: "tela", Operator: ">", Value: "13"},
+				{Key: "preco", Operator: "<=", Value: "100"},
+			},
+		},
+		{
+			name:     "diferença",
+			rawQuery: "attr.cor!=preto",
+			want:     []repositories.AttributeFilter{{Key: "cor", Operator: "!=", Value: "preto"}},
+		},
+		{
+			name:     "operador codificado",
+			rawQuery: "attr.ram_gb%3E%3D16",
+			want:     []repositories.AttributeFilter{{Key: "ram_gb", Operator: ">=", Value: "16"}},
+		},
+		{
+			name:     "ponto codificado",
+			rawQuery: "attr%2Ecor=azul",
+			want:     []repositories.AttributeFilter{{Key: "cor", Operator: "=", Value: "azul"}},
+		},
+		{
+			name:     "mais é mantido",
+			rawQuery: "attr.linguagem=c++",
+			want:     []repositories.AttributeFilter{{Key: "linguagem", Operator: "=", Value: "c++"}},
+		},
+		{
+			name:     "espaço codificado",
+			rawQuery: "attr.marca=Rock%20Content",
+			want:     []repositories.AttributeFilter{{Key: "marca", Operator: "=", Value: "Rock Content"}},
+		},
+		{
+			name:     "ignora outros parâmetros",
+			rawQuery: "q=notebook&attr.ssd=true&page=1",
+			want:     []repositories.AttributeFilter{{Key: "ssd", Operator: "=", Value: "true"}},
+		},
+		{name: "valor vazio", rawQuery: "attr.cor=", wantField: "attr"},
+		{name: "sem operador", rawQuery: "attr.cor", wantField: "attr"},
+		{name: "chave inválida", rawQuery: "attr.Cor=azul", wantField: "attr"},
+		{name: "codificação inválida", rawQuery: "attr.cor=%zz", wantField: "attr"},
+		{name: "comparação não numérica", rawQuery: "attr.ram_gb>=muito", wantField: "attr.ram_gb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseAttributeFilters(tt.rawQuery)
+			if tt.wantField != "" {
+				var ucErr *usecases.Error
+				if !errors.As(err, &ucErr) || ucErr.Fields[0].Field != tt.wantField {
+					t.Fatalf("erro = %#v, esperado erro no parâmetro %s", err, tt.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseAttributeFilters(%q) retornou erro: %v", tt.rawQuery, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseAttributeFilters(%q) = %+v, esperado %+v", tt.rawQuery, got, tt.want)
+			}
+		})
+	}
+}
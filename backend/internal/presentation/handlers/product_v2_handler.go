@@ -0,0 +1,401 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProductV2Handler gerencia os endpoints HTTP de produtos da API v2
+type ProductV2Handler struct {
+	productUseCase usecases.ProductUseCase
+}
+
+// NewProductV2Handler cria uma nova instância de ProductV2Handler
+func NewProductV2Handler(productUseCase usecases.ProductUseCase) *ProductV2Handler {
+	return &ProductV2Handler{
+		productUseCase: productUseCase,
+	}
+}
+
+// GetProducts retorna os produtos paginados com filtros opcionais
+// @Summary Listar produtos (v2)
+// @Description Retorna os produtos paginados, com a paginação em meta. Aceita os mesmos filtros da v1
+// @Tags products-v2
+// @Produce json
+// @Param q query string false "Busca textual por nome e descrição, ordenada por relevância"
+// @Param category_id query []int false "Filtrar por ID da categoria (repetível)" collectionFormat(multi)
+// @Param page query int false "Número da página (padrão 1)"
+// @Param page_size query int false "Itens por página (padrão 20, máximo 100)"
+// @Param sort query string false "Ordenação, ex: price,-created_at"
+// @Success 200 {object} dto.ProductsV2Response
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Router /v2/products [get]
+func (h *ProductV2Handler) GetProducts(c *gin.Context) {
+	filters, err := bindProductFilter(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	products, total, err := h.productUseCase.GetProducts(filters)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	data := make([]dto.ProductV2Response, len(products))
+	for i, product := range products {
+		data[i] = mapToProductV2Response(product)
+	}
+
+	totalPages := int((total + int64(filters.PageSize) - 1) / int64(filters.PageSize))
+
+	c.JSON(http.StatusOK, dto.ProductsV2Response{
+		Data: data,
+		Meta: dto.PageMetaV2{
+			Total:      total,
+			Page:       filters.Page,
+			PageSize:   filters.PageSize,
+			TotalPages: totalPages,
+		},
+		Links: buildPaginationLinks(c.Request.URL, filters.Page, totalPages),
+	})
+}
+
+// GetProduct retorna um produto pelo ID
+// @Summary Buscar produto por ID (v2)
+// @Tags products-v2
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Param If-None-Match header string false "ETag já conhecida pelo cliente"
+// @Success 200 {object} dto.SingleProductV2Response
+// @Success 304 "Produto não alterado desde a ETag informada"
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Router /v2/products/{id} [get]
+func (h *ProductV2Handler) GetProduct(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	product, err := h.productUseCase.GetProduct(uint(id))
+	h.respondProduct(c, product, err)
+}
+
+// GetProductBySKU retorna um produto pelo SKU
+// @Summary Buscar produto por SKU (v2)
+// @Tags products-v2
+// @Produce json
+// @Param sku path string true "SKU do produto"
+// @Success 200 {object} dto.SingleProductV2Response
+// @Failure 404 {object} dto.ProblemResponse
+// @Router /v2/products/by-sku/{sku} [get]
+func (h *ProductV2Handler) GetProductBySKU(c *gin.Context) {
+	product, err := h.productUseCase.GetProductBySKU(c.Param("sku"))
+	h.respondProduct(c, product, err)
+}
+
+// GetProductBySlug retorna um produto pelo slug
+// @Summary Buscar produto por slug (v2)
+// @Tags products-v2
+// @Produce json
+// @Param slug path string true "Slug do produto"
+// @Success 200 {object} dto.SingleProductV2Response
+// @Failure 404 {object} dto.ProblemResponse
+// @Router /v2/products/by-slug/{slug} [get]
+func (h *ProductV2Handler) GetProductBySlug(c *gin.Context) {
+	product, err := h.productUseCase.GetProductBySlug(c.Param("slug"))
+	h.respondProduct(c, product, err)
+}
+
+// respondProduct envia o produto lido com a sua ETag, ou o erro da leitura
+func (h *ProductV2Handler) respondProduct(c *gin.Context, product *entities.Product, err error) {
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	if writeETag(c, productTag(*product)) {
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SingleProductV2Response{Data: mapToProductV2Response(*product)})
+}
+
+// CreateProduct cria um novo produto
+// @Summary Criar produto (v2)
+// @Description Cria um novo produto; o preço é um objeto com amount em texto
+// @Tags products-v2
+// @Accept json
+// @Produce json
+// @Param product body dto.ProductV2Request true "Dados do produto"
+// @Success 201 {object} dto.SingleProductV2Response
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 409 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /v2/products [post]
+func (h *ProductV2Handler) CreateProduct(c *gin.Context) {
+	input, err := bindProductV2Input(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	product, err := h.productUseCase.CreateProduct(c.Request.Context(), *input)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("ETag", productTag(*product))
+	c.JSON(http.StatusCreated, dto.SingleProductV2Response{Data: mapToProductV2Response(*product)})
+}
+
+// UpdateProduct substitui um produto existente
+// @Summary Atualizar produto (v2)
+// @Tags products-v2
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Param If-Match header string true "ETag obtida na leitura do produto, ou * para ignorar a versão"
+// @Param product body dto.ProductV2Request true "Dados do produto"
+// @Success 200 {object} dto.SingleProductV2Response
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Failure 409 {object} dto.ProblemResponse
+// @Failure 412 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Failure 428 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /v2/products/{id} [put]
+func (h *ProductV2Handler) UpdateProduct(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	version, ok := requireIfMatch(c)
+	if !ok {
+		return
+	}
+
+	input, err := bindProductV2Input(c)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	input.Version = version
+
+	product, err := h.productUseCase.UpdateProduct(c.Request.Context(), uint(id), *input)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("ETag", productTag(*product))
+	c.JSON(http.StatusOK, dto.SingleProductV2Response{Data: mapToProductV2Response(*product)})
+}
+
+// PatchProduct atualiza parcialmente um produto
+// @Summary Atualizar produto parcialmente (v2)
+// @Description Aplica um JSON Merge Patch (RFC 7396). price é um objeto e aceita apenas amount, apenas currency ou ambos
+// @Tags products-v2
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Param If-Match header string true "ETag obtida na leitura do produto, ou * para ignorar a versão"
+// @Param product body dto.ProductV2PatchRequest true "Campos a alterar"
+// @Success 200 {object} dto.PatchProductV2Response
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Failure 409 {object} dto.ProblemResponse
+// @Failure 412 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Failure 428 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /v2/products/{id} [patch]
+func (h *ProductV2Handler) PatchProduct(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	version, ok := requireIfMatch(c)
+	if !ok {
+		return
+	}
+
+	body, err := bindMergePatch(c, productV2PatchFields...)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// O preço da v2 é um objeto; os demais campos seguem o patch da v1
+	amount, currency, err := body.money("price")
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	delete(body, "price")
+
+	patch, err := productPatchFrom(body)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	patch.Price = amount
+	patch.Currency = currency
+	patch.Version = version
+
+	product, changed, err := h.productUseCase.PatchProduct(c.Request.Context(), uint(id), *patch)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("ETag", productTag(*product))
+	c.JSON(http.StatusOK, dto.PatchProductV2Response{
+		Data:    mapToProductV2Response(*product),
+		Changed: changed,
+	})
+}
+
+// productV2PatchFields lista os campos aceitos em um JSON Merge Patch de produto na v2
+var productV2PatchFields = []string{"sku", "slug", "name", "image", "price", "category_id", "description", "attributes"}
+
+// DeleteProduct remove um produto
+// @Summary Deletar produto (v2)
+// @Tags products-v2
+// @Param id path int true "ID do produto"
+// @Param If-Match header string true "ETag obtida na leitura do produto, ou * para ignorar a versão"
+// @Success 204 "Produto removido"
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Failure 412 {object} dto.ProblemResponse
+// @Failure 428 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /v2/products/{id} [delete]
+func (h *ProductV2Handler) DeleteProduct(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	version, ok := requireIfMatch(c)
+	if !ok {
+		return
+	}
+
+	if err := h.productUseCase.DeleteProduct(c.Request.Context(), uint(id), version); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// bindProductV2Input lê o corpo de criação ou substituição de produto da v2
+func bindProductV2Input(c *gin.Context) (*usecases.ProductInput, error) {
+	var req dto.ProductV2Request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return nil, bindingError(err, "Dados inválidos")
+	}
+
+	price, err := entities.ParseMoney(req.Price.Amount, req.Price.Currency)
+	if err != nil {
+		return nil, usecases.NewValidationError("invalid_price", err.Error(),
+			usecases.FieldError{Field: "price.amount", Message: err.Error()})
+	}
+
+	return &usecases.ProductInput{
+		Name:        req.Name,
+		Image:       req.Image,
+		Description: req.Description,
+		Price:       price,
+		CategoryID:  req.CategoryID,
+		SKU:         req.SKU,
+		Slug:        req.Slug,
+		Attributes:  req.Attributes,
+	}, nil
+}
+
+// mapToMoneyV2 converte um valor monetário para o formato da v2
+func mapToMoneyV2(money entities.Money) dto.MoneyV2 {
+	return dto.MoneyV2{Amount: money.String(), Currency: money.Currency}
+}
+
+// mapToProductV2Response converte entidade para DTO de resposta da v2
+func mapToProductV2Response(product entities.Product) dto.ProductV2Response {
+	variants := make([]dto.VariantV2Response, len(product.Variants))
+	for i, variant := range product.Variants {
+		price := product.Price
+		if variant.Price != nil {
+			price = *variant.Price
+		}
+		variants[i] = dto.VariantV2Response{
+			ID:                variant.ID,
+			SKU:               variant.SKU,
+			Options:           variant.Options,
+			Price:             mapToMoneyV2(price),
+			PriceOverride:     variant.Price != nil,
+			Image:             variant.Image,
+			InStock:           variant.StockQuantity > 0,
+			AvailableQuantity: variant.StockQuantity,
+		}
+	}
+
+	response := dto.ProductV2Response{
+		ID:                product.ID,
+		SKU:               product.SKU,
+		Slug:              product.Slug,
+		Name:              product.Name,
+		Image:             product.Image,
+		Price:             mapToMoneyV2(product.Price),
+		Category:          dto.CategoryBreadcrumb{ID: product.Category.ID, Name: product.Category.Name},
+		Description:       product.Description,
+		Attributes:        product.Attributes,
+		InStock:           availableQuantity(product) > 0,
+		AvailableQuantity: availableQuantity(product),
+		Options:           mapToOptionResponses(product.Options),
+		Variants:          variants,
+		Version:           product.Version,
+		CreatedAt:         product.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         product.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if product.Highlight != nil {
+		response.Highlight = &dto.HighlightResponse{
+			Name:        product.Highlight.Name,
+			Description: product.Highlight.Description,
+			Rank:        product.SearchRank,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/repositories"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StockHandler gerencia os endpoints HTTP para o estoque de produtos
+type StockHandler struct {
+	stockUseCase usecases.StockUseCase
+}
+
+// NewStockHandler cria uma nova instância de StockHandler
+func NewStockHandler(stockUseCase usecases.StockUseCase) *StockHandler {
+	return &StockHandler{
+		stockUseCase: stockUseCase,
+	}
+}
+
+// CreateMovement registra uma movimentação de estoque
+// @Summary Registrar movimentação de estoque
+// @Description Registra uma entrada (receipt), venda (sale), ajuste (adjustment) ou devolução (return) do produto ou de uma variante (variant_id). Vendas e ajustes negativos falham com 409 se não houver saldo suficiente
+// @Tags stock
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Param movement body dto.StockMovementRequest true "Dados da movimentação"
+// @Success 201 {object} dto.SingleStockMovementResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /products/{id}/stock/movements [post]
+func (h *StockHandler) CreateMovement(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	var req dto.StockMovementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	movement, err := h.stockUseCase.RecordMovement(c.Request.Context(), uint(id), req.VariantID, entities.StockMovementType(req.Type), req.Quantity, req.Note)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dto.SingleStockMovementResponse{
+		Data: h.mapToStockMovementResponse(*movement),
+	})
+}
+
+// GetMovements retorna o histórico de movimentações de estoque de um produto
+// @Summary Histórico de estoque
+// @Description Retorna as movimentações de estoque de um produto, da mais recente para a mais antiga
+// @Tags stock
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Param page query int false "Número da página (padrão 1)"
+// @Param page_size query int false "Itens por página (padrão 20, máximo 100)"
+// @Success 200 {object} dto.StockMovementsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /products/{id}/stock/movements [get]
+func (h *StockHandler) GetMovements(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	var req dto.StockMovementsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.Error(bindingError(err, "Parâmetros de paginação inválidos"))
+		return
+	}
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.PageSize == 0 {
+		req.PageSize = repositories.DefaultPageSize
+	}
+
+	movements, total, err := h.stockUseCase.GetMovements(uint(id), req.Page, req.PageSize)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	responses := make([]dto.StockMovementResponse, len(movements))
+	for i, movement := range movements {
+		responses[i] = h.mapToStockMovementResponse(movement)
+	}
+
+	c.JSON(http.StatusOK, dto.StockMovementsResponse{
+		Data:     responses,
+		Total:    total,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+}
+
+// mapToStockMovementResponse converte entidade para DTO de resposta
+func (h *StockHandler) mapToStockMovementResponse(movement entities.StockMovement) dto.StockMovementResponse {
+	return dto.StockMovementResponse{
+		ID:           movement.ID,
+		ProductID:    movement.ProductID,
+		VariantID:    movement.VariantID,
+		Type:         string(movement.Type),
+		Quantity:     movement.Quantity,
+		BalanceAfter: movement.BalanceAfter,
+		Note:         movement.Note,
+		CreatedAt:    movement.CreatedAt.Format(time.RFC3339),
+	}
+}
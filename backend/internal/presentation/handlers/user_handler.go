@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UserHandler gerencia os endpoints HTTP de administração de usuários
+type UserHandler struct {
+	userUseCase usecases.UserUseCase
+}
+
+// NewUserHandler cria uma nova instância de UserHandler
+func NewUserHandler(userUseCase usecases.UserUseCase) *UserHandler {
+	return &UserHandler{
+		userUseCase: userUseCase,
+	}
+}
+
+// GetUsers retorna todos os usuários
+// @Summary Listar usuários
+// @Description Retorna todos os usuários, ordenados pelo e-mail
+// @Tags users
+// @Produce json
+// @Success 200 {object} dto.UsersResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /users [get]
+func (h *UserHandler) GetUsers(c *gin.Context) {
+	users, err := h.userUseCase.GetUsers(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	data := make([]dto.UserResponse, len(users))
+	for i := range users {
+		data[i] = mapToUserResponse(&users[i])
+	}
+	c.JSON(http.StatusOK, dto.UsersResponse{Data: data, Meta: dto.ListMetaV2{Total: len(data)}})
+}
+
+// GetUser retorna um usuário pelo ID
+// @Summary Buscar usuário por ID
+// @Description Retorna um usuário pelo ID
+// @Tags users
+// @Produce json
+// @Param id path int true "ID do usuário"
+// @Success 200 {object} dto.SingleUserResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /users/{id} [get]
+func (h *UserHandler) GetUser(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	user, err := h.userUseCase.GetUser(c.Request.Context(), uint(id))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.SingleUserResponse{Data: mapToUserResponse(user)})
+}
+
+// CreateUser cria um usuário com os papéis informados
+// @Summary Criar usuário
+// @Description Cria um usuário com os papéis informados (viewer, editor, admin)
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param user body dto.UserCreateRequest true "Dados do usuário"
+// @Success 201 {object} dto.SingleUserResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 409 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /users [post]
+func (h *UserHandler) CreateUser(c *gin.Context) {
+	var req dto.UserCreateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	user, err := h.userUseCase.CreateUser(c.Request.Context(), usecases.UserInput{
+		Email:    req.Email,
+		Name:     req.Name,
+		Password: req.Password,
+		Roles:    rolesFrom(req.Roles),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusCreated, dto.SingleUserResponse{Data: mapToUserResponse(user)})
+}
+
+// UpdateUser altera o nome e os papéis de um usuário
+// @Summary Atualizar usuário
+// @Description Altera o nome e os papéis de um usuário; campos ausentes são mantidos. Alterar os papéis encerra as sessões do usuário
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do usuário"
+// @Param user body dto.UserPatchRequest true "Campos alterados"
+// @Success 200 {object} dto.SingleUserResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /users/{id} [patch]
+func (h *UserHandler) UpdateUser(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	var req dto.UserPatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	patch := usecases.UserPatch{Name: req.Name}
+	if req.Roles != nil {
+		roles := rolesFrom(*req.Roles)
+		patch.Roles = &roles
+	}
+
+	user, err := h.userUseCase.UpdateUser(c.Request.Context(), uint(id), patch)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.SingleUserResponse{Data: mapToUserResponse(user)})
+}
+
+// SetPassword redefine a senha de um usuário
+// @Summary Redefinir senha
+// @Description Redefine a senha de um usuário sem exigir a atual e encerra as sessões dele
+// @Tags users
+// @Accept json
+// @Param id path int true "ID do usuário"
+// @Param request body dto.SetPasswordRequest true "Nova senha"
+// @Success 204 "Senha redefinida"
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Failure 422 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /users/{id}/password [put]
+func (h *UserHandler) SetPassword(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	var req dto.SetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	if err := h.userUseCase.SetPassword(c.Request.Context(), uint(id), req.Password); err != nil {
+		c.Error(err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// DisableUser desativa um usuário
+// @Summary Desativar usuário
+// @Description Impede novos logins do usuário e encerra as sessões dele. Tokens de acesso já emitidos valem até expirar
+// @Tags users
+// @Produce json
+// @Param id path int true "ID do usuário"
+// @Success 200 {object} dto.SingleUserResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Failure 409 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /users/{id}/disable [post]
+func (h *UserHandler) DisableUser(c *gin.Context) {
+	h.setDisabled(c, h.userUseCase.DisableUser)
+}
+
+// EnableUser reativa um usuário
+// @Summary Reativar usuário
+// @Description Reativa um usuário desativado
+// @Tags users
+// @Produce json
+// @Param id path int true "ID do usuário"
+// @Success 200 {object} dto.SingleUserResponse
+// @Failure 400 {object} dto.ProblemResponse
+// @Failure 401 {object} dto.ProblemResponse
+// @Failure 403 {object} dto.ProblemResponse
+// @Failure 404 {object} dto.ProblemResponse
+// @Security BearerAuth
+// @Router /users/{id}/enable [post]
+func (h *UserHandler) EnableUser(c *gin.Context) {
+	h.setDisabled(c, h.userUseCase.EnableUser)
+}
+
+// setDisabled aplica a desativação ou reativação do usuário da rota
+func (h *UserHandler) setDisabled(c *gin.Context, apply func(ctx context.Context, id uint) (*entities.User, error)) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	user, err := apply(c.Request.Context(), uint(id))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, dto.SingleUserResponse{Data: mapToUserResponse(user)})
+}
+
+// rolesFrom converte os papéis da requisição; a validação fica com o caso de uso
+func rolesFrom(names []string) []entities.Role {
+	roles := make([]entities.Role, len(names))
+	for i, name := range names {
+		roles[i] = entities.Role(name)
+	}
+	return roles
+}
+
+// mapToUserResponse converte um usuário para a resposta
+func mapToUserResponse(user *entities.User) dto.UserResponse {
+	roles := make([]string, len(user.Roles))
+	for i, role := range user.Roles {
+		roles[i] = string(role)
+	}
+	return dto.UserResponse{
+		ID:          user.ID,
+		Email:       user.Email,
+		Name:        user.Name,
+		Roles:       roles,
+		Disabled:    user.Disabled,
+		LastLoginAt: user.LastLoginAt,
+		CreatedAt:   user.CreatedAt,
+		UpdatedAt:   user.UpdatedAt,
+	}
+}
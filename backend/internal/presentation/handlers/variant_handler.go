@@ -0,0 +1,383 @@
+package handlers
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VariantHandler gerencia os endpoints HTTP para opções e variantes de produtos
+type VariantHandler struct {
+	variantUseCase usecases.VariantUseCase
+	productUseCase usecases.ProductUseCase
+}
+
+// NewVariantHandler cria uma nova instância de VariantHandler
+func NewVariantHandler(variantUseCase usecases.VariantUseCase, productUseCase usecases.ProductUseCase) *VariantHandler {
+	return &VariantHandler{
+		variantUseCase: variantUseCase,
+		productUseCase: productUseCase,
+	}
+}
+
+// GetOptions retorna os eixos de variação de um produto
+// @Summary Listar opções do produto
+// @Description Retorna os eixos de variação (ex: tamanho, cor) de um produto
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Success 200 {object} dto.ProductOptionsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /products/{id}/options [get]
+func (h *VariantHandler) GetOptions(c *gin.Context) {
+	product, ok := h.loadProduct(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ProductOptionsResponse{Data: mapToOptionResponses(product.Options)})
+}
+
+// SetOptions define os eixos de variação de um produto
+// @Summary Definir opções do produto
+// @Description Substitui os eixos de variação de um produto. Falha se alguma variante existente deixar de ser válida
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Param options body dto.ProductOptionsRequest true "Eixos de variação"
+// @Success 200 {object} dto.ProductOptionsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /products/{id}/options [put]
+func (h *VariantHandler) SetOptions(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	var req dto.ProductOptionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return
+	}
+
+	options := make([]entities.ProductOption, len(req.Options))
+	for i, option := range req.Options {
+		options[i] = entities.ProductOption{Name: option.Name, Values: option.Values}
+	}
+
+	options, err = h.variantUseCase.SetOptions(c.Request.Context(), uint(id), options)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.ProductOptionsResponse{Data: mapToOptionResponses(options)})
+}
+
+// GetVariants retorna as opções e variantes de um produto
+// @Summary Listar variantes
+// @Description Retorna os eixos de variação e as variantes de um produto
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Success 200 {object} dto.VariantsResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /products/{id}/variants [get]
+func (h *VariantHandler) GetVariants(c *gin.Context) {
+	product, ok := h.loadProduct(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.VariantsResponse{
+		Options: mapToOptionResponses(product.Options),
+		Data:    mapToVariantResponses(*product),
+		Total:   len(product.Variants),
+	})
+}
+
+// GetVariant retorna uma variante de um produto
+// @Summary Buscar variante
+// @Description Retorna uma variante específica de um produto
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Param variantId path int true "ID da variante"
+// @Success 200 {object} dto.SingleVariantResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /products/{id}/variants/{variantId} [get]
+func (h *VariantHandler) GetVariant(c *gin.Context) {
+	product, ok := h.loadProduct(c)
+	if !ok {
+		return
+	}
+
+	variantID, err := strconv.ParseUint(c.Param("variantId"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidVariantID)
+		return
+	}
+
+	for _, variant := range product.Variants {
+		if variant.ID == uint(variantID) {
+			c.JSON(http.StatusOK, dto.SingleVariantResponse{Data: mapToVariantResponse(variant, *product)})
+			return
+		}
+	}
+
+	c.Error(usecases.ErrVariantNotFound)
+}
+
+// CreateVariant cria uma variante para o produto
+// @Summary Criar variante
+// @Description Cria uma variante com uma combinação de opções, SKU, preço e imagem próprios
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Param variant body dto.VariantRequest true "Dados da variante"
+// @Success 201 {object} dto.SingleVariantResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /products/{id}/variants [post]
+func (h *VariantHandler) CreateVariant(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	input, ok := h.bindVariantInput(c, uint(id))
+	if !ok {
+		return
+	}
+
+	variant, err := h.variantUseCase.CreateVariant(c.Request.Context(), uint(id), input)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.respondVariant(c, http.StatusCreated, *variant)
+}
+
+// UpdateVariant atualiza uma variante do produto
+// @Summary Atualizar variante
+// @Description Atualiza uma variante existente
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Param variantId path int true "ID da variante"
+// @Param variant body dto.VariantRequest true "Dados da variante"
+// @Success 200 {object} dto.SingleVariantResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /products/{id}/variants/{variantId} [put]
+func (h *VariantHandler) UpdateVariant(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	variantID, err := strconv.ParseUint(c.Param("variantId"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidVariantID)
+		return
+	}
+
+	input, ok := h.bindVariantInput(c, uint(id))
+	if !ok {
+		return
+	}
+
+	variant, err := h.variantUseCase.UpdateVariant(c.Request.Context(), uint(id), uint(variantID), input)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.respondVariant(c, http.StatusOK, *variant)
+}
+
+// DeleteVariant remove uma variante do produto
+// @Summary Deletar variante
+// @Description Remove uma variante do produto
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Param id path int true "ID do produto"
+// @Param variantId path int true "ID da variante"
+// @Success 200 {object} dto.MessageResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Security BearerAuth
+// @Security APIKeyAuth
+// @Router /products/{id}/variants/{variantId} [delete]
+func (h *VariantHandler) DeleteVariant(c *gin.Context) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return
+	}
+
+	variantID, err := strconv.ParseUint(c.Param("variantId"), 10, 32)
+	if err != nil {
+		c.Error(errInvalidVariantID)
+		return
+	}
+
+	if err := h.variantUseCase.DeleteVariant(c.Request.Context(), uint(id), uint(variantID)); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.MessageResponse{Message: "Variante removida com sucesso"})
+}
+
+// loadProduct busca o produto do parâmetro id, respondendo com erro se necessário
+func (h *VariantHandler) loadProduct(c *gin.Context) (*entities.Product, bool) {
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.Error(errInvalidID)
+		return nil, false
+	}
+
+	product, err := h.productUseCase.GetProduct(uint(id))
+	if err != nil {
+		c.Error(err)
+		return nil, false
+	}
+
+	return product, true
+}
+
+// bindVariantInput lê e converte o corpo da requisição de variante
+func (h *VariantHandler) bindVariantInput(c *gin.Context, productID uint) (usecases.VariantInput, bool) {
+	var req dto.VariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(bindingError(err, "Dados inválidos"))
+		return usecases.VariantInput{}, false
+	}
+
+	input := usecases.VariantInput{
+		SKU:     req.SKU,
+		Options: req.Options,
+		Image:   req.Image,
+	}
+
+	if req.Price != nil {
+		// O preço da variante usa a moeda do produto
+		product, err := h.productUseCase.GetProduct(productID)
+		if err != nil {
+			c.Error(err)
+			return usecases.VariantInput{}, false
+		}
+
+		price, err := entities.ParseMoney(req.Price.String(), product.Price.Currency)
+		if err != nil {
+			c.Error(invalidPrice(err))
+			return usecases.VariantInput{}, false
+		}
+		input.Price = &price
+	}
+
+	return input, true
+}
+
+// respondVariant responde com a variante e o preço efetivo calculado a partir do produto
+func (h *VariantHandler) respondVariant(c *gin.Context, status int, variant entities.ProductVariant) {
+	product, err := h.productUseCase.GetProduct(variant.ProductID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(status, dto.SingleVariantResponse{Data: mapToVariantResponse(variant, *product)})
+}
+
+// mapToOptionResponses converte os eixos de variação para DTOs de resposta
+func mapToOptionResponses(options []entities.ProductOption) []dto.ProductOptionResponse {
+	responses := make([]dto.ProductOptionResponse, len(options))
+	for i, option := range options {
+		responses[i] = dto.ProductOptionResponse{
+			ID:       option.ID,
+			Name:     option.Name,
+			Position: option.Position,
+			Values:   option.Values,
+		}
+	}
+	return responses
+}
+
+// mapToVariantResponses converte as variantes do produto para DTOs de resposta
+func mapToVariantResponses(product entities.Product) []dto.VariantResponse {
+	responses := make([]dto.VariantResponse, len(product.Variants))
+	for i, variant := range product.Variants {
+		responses[i] = mapToVariantResponse(variant, product)
+	}
+	return responses
+}
+
+// mapToVariantResponse converte a variante para DTO de resposta usando o preço do produto como padrão
+func mapToVariantResponse(variant entities.ProductVariant, product entities.Product) dto.VariantResponse {
+	price := product.Price
+	if variant.Price != nil {
+		price = *variant.Price
+	}
+
+	return dto.VariantResponse{
+		ID:                variant.ID,
+		ProductID:         variant.ProductID,
+		SKU:               variant.SKU,
+		Options:           variant.Options,
+		Price:             json.Number(price.String()),
+		Currency:          price.Currency,
+		PriceOverride:     variant.Price != nil,
+		Image:             variant.Image,
+		InStock:           variant.StockQuantity > 0,
+		AvailableQuantity: variant.StockQuantity,
+		CreatedAt:         variant.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         variant.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"catalogo-produtos/backend/internal/domain/entities"
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ClaimsKey é a chave das claims do token autenticado no contexto do Gin
+const ClaimsKey = "auth.claims"
+
+// APIKeyHeader é o cabeçalho com a chave de API das integrações
+const APIKeyHeader = "X-API-Key"
+
+// errAmbiguousCredentials indica uma requisição com token de acesso e chave de API ao mesmo tempo
+var errAmbiguousCredentials = usecases.NewBadRequestError("ambiguous_credentials",
+	"informe um token de acesso ou uma chave de API, não ambos")
+
+// Authenticator valida as credenciais das requisições: um token de acesso no cabeçalho
+// Authorization ("Bearer <token>") ou uma chave de API no cabeçalho X-API-Key
+type Authenticator struct {
+	authUseCase   usecases.AuthUseCase
+	apiKeyUseCase usecases.APIKeyUseCase
+}
+
+// NewAuthenticator cria uma nova instância de Authenticator
+func NewAuthenticator(authUseCase usecases.AuthUseCase, apiKeyUseCase usecases.APIKeyUseCase) *Authenticator {
+	return &Authenticator{
+		authUseCase:   authUseCase,
+		apiKeyUseCase: apiKeyUseCase,
+	}
+}
+
+// RequirePermission cria a verificação declarada em cada rota: a rota exige credenciais
+// válidas cujos papéis ou escopos concedam a permissão. Sem credenciais a resposta é 401; sem
+// a permissão, 403 com a permissão ausente. As regras são as do Authorizer dos casos de uso,
+// que também as aplicam.
+func (a *Authenticator) RequirePermission(authorizer usecases.Authorizer) func(permission entities.Permission) gin.HandlerFunc {
+	return func(permission entities.Permission) gin.HandlerFunc {
+		return func(c *gin.Context) {
+			if !a.authenticate(c) {
+				return
+			}
+			if err := authorizer.Authorize(c.Request.Context(), permission); err != nil {
+				if errors.Is(err, usecases.ErrAuthenticationRequired) {
+					unauthorized(c, err, "")
+					return
+				}
+				c.Error(err)
+				c.Abort()
+				return
+			}
+			c.Next()
+		}
+	}
+}
+
+// Authenticate exige credenciais válidas, sem verificar permissões; usado nas rotas
+// da própria conta (ex: troca de senha)
+func (a *Authenticator) Authenticate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !a.authenticate(c) {
+			return
+		}
+		if ClaimsFrom(c) == nil {
+			unauthorized(c, usecases.ErrAuthenticationRequired, "")
+			return
+		}
+		c.Next()
+	}
+}
+
+// OptionalAuthentication valida as credenciais, se informadas, e coloca as claims no
+// contexto; requisições sem credenciais seguem anônimas. Credenciais inválidas são sempre recusadas.
+func (a *Authenticator) OptionalAuthentication() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if a.authenticate(c) {
+			c.Next()
+		}
+	}
+}
+
+// ClaimsFrom obtém as claims do token autenticado, ou nil se a requisição é anônima
+func ClaimsFrom(c *gin.Context) *entities.TokenClaims {
+	return usecases.ClaimsFromContext(c.Request.Context())
+}
+
+// authenticate valida o token do cabeçalho Authorization ou a chave do X-API-Key, se houver.
+// Retorna false, com a requisição já abortada, se as credenciais forem inválidas ou se as
+// duas forem enviadas juntas.
+func (a *Authenticator) authenticate(c *gin.Context) bool {
+	header := c.GetHeader("Authorization")
+	apiKey := c.GetHeader(APIKeyHeader)
+	if header == "" && apiKey == "" {
+		return true
+	}
+	if header != "" && apiKey != "" {
+		c.Error(errAmbiguousCredentials)
+		c.Abort()
+		return false
+	}
+
+	var claims *entities.TokenClaims
+	var err error
+	if apiKey != "" {
+		if claims, err = a.apiKeyUseCase.Authenticate(strings.TrimSpace(apiKey)); err != nil {
+			unauthorized(c, err, "")
+			return false
+		}
+	} else {
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+			unauthorized(c, usecases.ErrInvalidToken, "invalid_request")
+			return false
+		}
+
+		if claims, err = a.authUseCase.Authenticate(strings.TrimSpace(token)); err != nil {
+			unauthorized(c, err, "invalid_token")
+			return false
+		}
+	}
+
+	c.Set(ClaimsKey, claims)
+	c.Request = c.Request.WithContext(usecases.ContextWithClaims(c.Request.Context(), claims))
+	return true
+}
+
+// unauthorized recusa a requisição com 401 e o desafio Bearer (RFC 6750)
+func unauthorized(c *gin.Context, err error, bearerError string) {
+	challenge := `Bearer realm="catalogo-produtos"`
+	if bearerError != "" {
+		challenge += `, error="` + bearerError + `"`
+	}
+	c.Header("WWW-Authenticate", challenge)
+	c.Error(err)
+	c.Abort()
+}
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"catalogo-produtos/backend/internal/config"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+// corsExposedHeaders são os cabeçalhos de resposta que o navegador deixa o frontend ler
+var corsExposedHeaders = []string{"ETag", "Deprecation", "Sunset", "Link", "WWW-Authenticate"}
+
+// CORS cria o middleware de CORS com as origens, métodos e cabeçalhos configurados.
+// Uma origem com curinga (https://*.minhaloja.com.br) aceita qualquer subdomínio, mas não o
+// próprio domínio; "*" aceita qualquer origem e não pode ser combinado com credenciais.
+// Requisições de origens não aceitas são recusadas com 403.
+func CORS(cfg config.CORSConfig) (gin.HandlerFunc, error) {
+	corsConfig := cors.Config{
+		AllowMethods:     cfg.AllowedMethods,
+		AllowHeaders:     cfg.AllowedHeaders,
+		ExposeHeaders:    corsExposedHeaders,
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           cfg.MaxAge,
+	}
+
+	if len(cfg.AllowedOrigins) == 0 {
+		return nil, errors.New("CORS_ALLOWED_ORIGINS deve informar ao menos uma origem")
+	}
+	if allowsAnyOrigin(cfg.AllowedOrigins) {
+		if cfg.AllowCredentials {
+			return nil, errors.New(`CORS_ALLOWED_ORIGINS="*" não pode ser usado com CORS_ALLOW_CREDENTIALS`)
+		}
+		corsConfig.AllowAllOrigins = true
+	} else {
+		patterns := make([]originPattern, 0, len(cfg.AllowedOrigins))
+		for _, origin := range cfg.AllowedOrigins {
+			pattern, err := parseOriginPattern(origin)
+			if err != nil {
+				return nil, err
+			}
+			patterns = append(patterns, pattern)
+		}
+		corsConfig.AllowOriginFunc = func(origin string) bool {
+			return originAllowed(patterns, origin)
+		}
+	}
+
+	if err := corsConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("configuração de CORS inválida: %w", err)
+	}
+	return cors.New(corsConfig), nil
+}
+
+// originPattern é uma origem aceita; com wildcard, host é o domínio cujos subdomínios são aceitos
+type originPattern struct {
+	scheme   string
+	host     string
+	port     string
+	wildcard bool
+}
+
+// parseOriginPattern interpreta uma origem configurada no formato esquema://host[:porta]
+func parseOriginPattern(origin string) (originPattern, error) {
+	u, err := url.Parse(strings.ToLower(strings.TrimSpace(origin)))
+	if err != nil || u.Scheme == "" || u.Host == "" || u.User != nil ||
+		(u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return originPattern{}, fmt.Errorf("origem de CORS inválida: '%s' (use esquema://host[:porta])", origin)
+	}
+
+	pattern := originPattern{scheme: u.Scheme, host: u.Hostname(), port: u.Port()}
+	if domain, ok := strings.CutPrefix(pattern.host, "*."); ok {
+		pattern.host, pattern.wildcard = domain, true
+	}
+	if pattern.host == "" || strings.Contains(pattern.host, "*") {
+		return originPattern{}, fmt.Errorf("origem de CORS inválida: '%s' (o curinga só é aceito no início do host, ex: https://*.minhaloja.com.br)", origin)
+	}
+	return pattern, nil
+}
+
+// originAllowed verifica se a origem da requisição corresponde a alguma origem configurada
+func originAllowed(patterns []originPattern, origin string) bool {
+	u, err := url.Parse(strings.ToLower(origin))
+	if err != nil || u.Host == "" || u.User != nil || u.Path != "" || u.RawQuery != "" {
+		return false
+	}
+
+	host := u.Hostname()
+	for _, pattern := range patterns {
+		if u.Scheme != pattern.scheme || u.Port() != pattern.port {
+			continue
+		}
+		if pattern.wildcard {
+			if strings.HasSuffix(host, "."+pattern.host) {
+				return true
+			}
+		} else if host == pattern.host {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsAnyOrigin verifica se a configuração aceita qualquer origem
+func allowsAnyOrigin(origins []string) bool {
+	for _, origin := range origins {
+		if origin == "*" {
+			return true
+		}
+	}
+	return false
+}
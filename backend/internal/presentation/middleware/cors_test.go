@@ -0,0 +1,160 @@
+package middleware
+
+import (
+	"catalogo-produtos/backend/internal/config"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestParseOriginPattern(t *testing.T) {
+	tests := []struct {
+		origin  string
+		want    originPattern
+		wantErr bool
+	}{
+		{origin: "https://www.minhaloja.com.br", want: originPattern{scheme: "https", host: "www.minhaloja.com.br"}},
+		{origin: "http://localhost:3000", want: originPattern{scheme: "http", host: "localhost", port: "3000"}},
+		{origin: " HTTPS://Loja.COM/ ", want: originPattern{scheme: "https", host: "loja.com"}},
+		{origin: "https://*.minhaloja.com.br", want: originPattern{scheme: "https", host: "minhaloja.com.br", wildcard: true}},
+		{origin: "https://*.minhaloja.com.br:8443", want: originPattern{scheme: "https", host: "minhaloja.com.br", port: "8443", wildcard: true}},
+		{origin: "www.minhaloja.com.br", wantErr: true},
+		{origin: "https://", wantErr: true},
+		{origin: "https://www.minhaloja.com.br/loja", wantErr: true},
+		{origin: "https://www.minhaloja.com.br?x=1", wantErr: true},
+		{origin: "https://user@minhaloja.com.br", wantErr: true},
+		{origin: "https://*", wantErr: true},
+		{origin: "https://*.", wantErr: true},
+		{origin: "https://loja.*.com.br", wantErr: true},
+		{origin: "https://*.*.com.br", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.origin, func(t *testing.T) {
+			got, err := parseOriginPattern(tt.origin)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseOriginPattern(%q) = %+v, esperado erro", tt.origin, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseOriginPattern(%q) retornou erro: %v", tt.origin, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseOriginPattern(%q) = %+v, esperado %+v", tt.origin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOriginAllowed(t *testing.T) {
+	var patterns []originPattern
+	for _, origin := range []string{"https://*.minhaloja.com.br", "http://localhost:3000", "https://parceiro.com"} {
+		pattern, err := parseOriginPattern(origin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		patterns = append(patterns, pattern)
+	}
+
+	tests := []struct {
+		origin string
+		want   bool
+	}{
+		{origin: "https://www.minhaloja.com.br", want: true},
+		{origin: "https://admin.minhaloja.com.br", want: true},
+		{origin: "https://a.b.minhaloja.com.br", want: true},
+		{origin: "https://WWW.MinhaLoja.com.br", want: true},
+		{origin: "https://minhaloja.com.br", want: false},
+		{origin: "https://www.minhaloja.com.br.evil.com", want: false},
+		{origin: "https://evilminhaloja.com.br", want: false},
+		{origin: "http://www.minhaloja.com.br", want: false},
+		{origin: "https://www.minhaloja.com.br:8443", want: false},
+		{origin: "http://localhost:3000", want: true},
+		{origin: "http://localhost:5173", want: false},
+		{origin: "http://localhost", want: false},
+		{origin: "https://parceiro.com", want: true},
+		{origin: "https://www.parceiro.com", want: false},
+		{origin: "https://parceiro.com/pagina", want: false},
+		{origin: "https://user@parceiro.com", want: false},
+		{origin: "null", want: false},
+		{origin: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.origin, func(t *testing.T) {
+			if got := originAllowed(patterns, tt.origin); got != tt.want {
+				t.Errorf("originAllowed(%q) = %v, esperado %v", tt.origin, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCORS(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	base := config.CORSConfig{
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Content-Type", "Authorization"},
+		MaxAge:         time.Hour,
+	}
+
+	tests := []struct {
+		name        string
+		origins     []string
+		credentials bool
+		origin      string
+		wantErr     bool
+		wantStatus  int
+		// wantAllowOrigin é o Access-Control-Allow-Origin esperado na resposta
+		wantAllowOrigin string
+	}{
+		{name: "origem aceita", origins: []string{"https://*.loja.com"}, origin: "https://www.loja.com", wantStatus: http.StatusOK, wantAllowOrigin: "https://www.loja.com"},
+		{name: "origem recusada", origins: []string{"https://*.loja.com"}, origin: "https://loja.com", wantStatus: http.StatusForbidden},
+		{name: "sem Origin", origins: []string{"https://*.loja.com"}, wantStatus: http.StatusOK},
+		{name: "qualquer origem", origins: []string{"*"}, origin: "https://qualquer.com", wantStatus: http.StatusOK, wantAllowOrigin: "*"},
+		{name: "qualquer origem com credenciais", origins: []string{"*"}, credentials: true, wantErr: true},
+		{name: "sem origens", origins: nil, wantErr: true},
+		{name: "origem inválida", origins: []string{"loja.com"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := base
+			cfg.AllowedOrigins = tt.origins
+			cfg.AllowCredentials = tt.credentials
+
+			handler, err := CORS(cfg)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("CORS aceitou uma configuração inválida")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CORS retornou erro: %v", err)
+			}
+
+			router := gin.New()
+			router.Use(handler)
+			router.GET("/api/products", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			req := httptest.NewRequest(http.MethodGet, "/api/products", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, esperado %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.wantAllowOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, esperado %q", got, tt.wantAllowOrigin)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeprecationPolicy descreve a descontinuação de uma versão da API
+type DeprecationPolicy struct {
+	// Since é a data a partir da qual a versão é considerada descontinuada
+	Since time.Time
+	// Sunset é a data a partir da qual a versão pode deixar de responder
+	Sunset time.Time
+}
+
+// Deprecated marca as respostas como descontinuadas (cabeçalhos Deprecation, RFC 9745, e Sunset,
+// RFC 8594) e aponta no cabeçalho Link o endpoint equivalente da versão sucessora, trocando o
+// prefixo from do caminho por to
+func Deprecated(policy DeprecationPolicy, from, to string) gin.HandlerFunc {
+	deprecation := fmt.Sprintf("@%d", policy.Since.Unix())
+	sunset := policy.Sunset.UTC().Format(http.TimeFormat)
+
+	return func(c *gin.Context) {
+		c.Header("Deprecation", deprecation)
+		c.Header("Sunset", sunset)
+		if path := c.Request.URL.Path; strings.HasPrefix(path, from) {
+			c.Header("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, to+strings.TrimPrefix(path, from)))
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"catalogo-produtos/backend/internal/domain/usecases"
+	"catalogo-produtos/backend/internal/presentation/dto"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ProblemContentType é o tipo de mídia das respostas de erro (RFC 7807)
+const ProblemContentType = "application/problem+json"
+
+// problemTypePrefix forma o URI do tipo de problema a partir do código do erro
+const problemTypePrefix = "urn:catalogo-produtos:problem:"
+
+// kindStatus associa cada tipo de erro de domínio ao status HTTP correspondente
+var kindStatus = map[usecases.ErrorKind]int{
+	usecases.KindNotFound:             http.StatusNotFound,
+	usecases.KindConflict:             http.StatusConflict,
+	usecases.KindValidation:           http.StatusUnprocessableEntity,
+	usecases.KindBadRequest:           http.StatusBadRequest,
+	usecases.KindPreconditionFailed:   http.StatusPreconditionFailed,
+	usecases.KindPreconditionRequired: http.StatusPreconditionRequired,
+	usecases.KindAborted:              http.StatusFailedDependency,
+	usecases.KindUnauthenticated:      http.StatusUnauthorized,
+	usecases.KindPermissionDenied:     http.StatusForbidden,
+}
+
+// legacyErrorsKey marca no contexto as requisições que respondem erros no formato da v1
+const legacyErrorsKey = "errors.legacy"
+
+// ErrorHandler renderiza o último erro registrado pelos handlers com c.Error como
+// application/problem+json. Erros que não são de domínio viram 500 sem expor detalhes internos.
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		err := c.Errors.Last().Err
+		problem := ProblemFor(err)
+		problem.Instance = c.Request.URL.Path
+		if problem.Status == http.StatusInternalServerError {
+			log.Printf("Erro interno em %s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+		}
+
+		if c.GetBool(legacyErrorsKey) {
+			// Na v1, dados inválidos sempre responderam 400
+			status := problem.Status
+			if status == http.StatusUnprocessableEntity {
+				status = http.StatusBadRequest
+			}
+			c.JSON(status, dto.ErrorResponse{Error: problem.Detail})
+			return
+		}
+
+		c.Header("Content-Type", ProblemContentType)
+		c.JSON(problem.Status, problem)
+	}
+}
+
+// LegacyErrors faz o ErrorHandler responder os erros das rotas do grupo no formato original
+// da v1, {"error": "mensagem"}, com status 400 também para dados inválidos
+func LegacyErrors() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(legacyErrorsKey, true)
+		c.Next()
+	}
+}
+
+// StatusFor retorna o status HTTP correspondente a um erro
+func StatusFor(err error) int {
+	var domainErr *usecases.Error
+	if errors.As(err, &domainErr) {
+		if status, ok := kindStatus[domainErr.Kind]; ok {
+			return status
+		}
+	}
+	return http.StatusInternalServerError
+}
+
+// ProblemFor converte um erro em um problema RFC 7807
+func ProblemFor(err error) dto.ProblemResponse {
+	var domainErr *usecases.Error
+	status := StatusFor(err)
+	if status == http.StatusInternalServerError || !errors.As(err, &domainErr) {
+		return dto.ProblemResponse{
+			Type:   problemTypePrefix + "internal_error",
+			Title:  http.StatusText(http.StatusInternalServerError),
+			Status: http.StatusInternalServerError,
+			Detail: "Erro interno do servidor",
+			Code:   "internal_error",
+		}
+	}
+
+	problem := dto.ProblemResponse{
+		Type:       problemTypePrefix + domainErr.Code,
+		Title:      http.StatusText(status),
+		Status:     status,
+		Detail:     domainErr.Message,
+		Code:       domainErr.Code,
+		Extensions: domainErr.Details,
+	}
+	for _, field := range domainErr.Fields {
+		problem.Errors = append(problem.Errors, dto.ProblemField{Field: field.Field, Message: field.Message})
+	}
+	return problem
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Ambientes com cabeçalhos de segurança predefinidos
+const (
+	EnvironmentDevelopment = "development"
+	EnvironmentStaging     = "staging"
+	EnvironmentProduction  = "production"
+)
+
+// Políticas de conteúdo (CSP). A API só responde dados, então não carrega nenhum recurso;
+// a documentação (Swagger UI) carrega scripts, estilos e imagens próprios, parte deles inline.
+const (
+	apiContentSecurityPolicy  = "default-src 'none'; frame-ancestors 'none'; base-uri 'none'; form-action 'none'"
+	docsContentSecurityPolicy = "default-src 'self'; script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline'; " +
+		"img-src 'self' data:; connect-src 'self'; frame-ancestors 'none'; base-uri 'self'; form-action 'none'"
+)
+
+// SecurityPolicy define os cabeçalhos de segurança das respostas
+type SecurityPolicy struct {
+	// HSTSMaxAge é a validade do Strict-Transport-Security; zero não envia o cabeçalho
+	HSTSMaxAge time.Duration
+	// HSTSIncludeSubdomains estende o HSTS aos subdomínios
+	HSTSIncludeSubdomains bool
+	// ContentSecurityPolicy é a política das respostas da API
+	ContentSecurityPolicy string
+	// DocsContentSecurityPolicy é a política da documentação (Swagger UI)
+	DocsContentSecurityPolicy string
+	// CSPReportOnly apenas relata as violações da política (Content-Security-Policy-Report-Only), sem bloquear
+	CSPReportOnly  bool
+	ReferrerPolicy string
+}
+
+// SecurityPreset retorna os cabeçalhos do ambiente: no desenvolvimento, sem HSTS e com a CSP
+// apenas relatada; no staging, HSTS de um dia; em produção, HSTS de dois anos com subdomínios
+func SecurityPreset(environment string) (SecurityPolicy, error) {
+	policy := SecurityPolicy{
+		ContentSecurityPolicy:     apiContentSecurityPolicy,
+		DocsContentSecurityPolicy: docsContentSecurityPolicy,
+		ReferrerPolicy:            "no-referrer",
+	}
+
+	switch environment {
+	case EnvironmentDevelopment:
+		policy.CSPReportOnly = true
+	case EnvironmentStaging:
+		policy.HSTSMaxAge = 24 * time.Hour
+	case EnvironmentProduction:
+		policy.HSTSMaxAge = 2 * 365 * 24 * time.Hour
+		policy.HSTSIncludeSubdomains = true
+	default:
+		return SecurityPolicy{}, fmt.Errorf("ambiente desconhecido: '%s' (use %s, %s ou %s)",
+			environment, EnvironmentDevelopment, EnvironmentStaging, EnvironmentProduction)
+	}
+	return policy, nil
+}
+
+// SecurityHeaders aplica a política às respostas. Os caminhos com o prefixo docsPrefix usam a
+// política de conteúdo da documentação.
+func SecurityHeaders(policy SecurityPolicy, docsPrefix string) gin.HandlerFunc {
+	hsts := ""
+	if policy.HSTSMaxAge > 0 {
+		hsts = fmt.Sprintf("max-age=%d", int64(policy.HSTSMaxAge.Seconds()))
+		if policy.HSTSIncludeSubdomains {
+			hsts += "; includeSubDomains"
+		}
+	}
+	cspHeader := "Content-Security-Policy"
+	if policy.CSPReportOnly {
+		cspHeader = "Content-Security-Policy-Report-Only"
+	}
+
+	return func(c *gin.Context) {
+		c.Header("X-Content-Type-Options", "nosniff")
+		c.Header("X-Frame-Options", "DENY")
+		c.Header("Referrer-Policy", policy.ReferrerPolicy)
+		if hsts != "" {
+			c.Header("Strict-Transport-Security", hsts)
+		}
+		if strings.HasPrefix(c.Request.URL.Path, docsPrefix) {
+			c.Header(cspHeader, policy.DocsContentSecurityPolicy)
+		} else {
+			c.Header(cspHeader, policy.ContentSecurityPolicy)
+		}
+		c.Next()
+	}
+}
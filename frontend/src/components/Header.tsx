@@ -1,8 +1,10 @@
 
 import React, { useState } from 'react';
-import { ShoppingCart, Search, Menu, X } from 'lucide-react';
+import { ShoppingCart, Search, Menu, X, LogIn, LogOut } from 'lucide-react';
 import { useCart } from '../context/CartContext';
+import { useAuth } from '../context/AuthContext';
 import Cart from './Cart';
+import LoginModal from './LoginModal';
 
 interface HeaderProps {
   onSearchChange: (search: string) => void;
@@ -12,8 +14,10 @@ interface HeaderProps {
 
 const Header: React.FC<HeaderProps> = ({ onSearchChange, onMenuToggle, isMobileMenuOpen }) => {
   const [isCartOpen, setIsCartOpen] = useState(false);
+  const [isLoginOpen, setIsLoginOpen] = useState(false);
   const [searchTerm, setSearchTerm] = useState('');
   const { getTotalItems } = useCart();
+  const { isAuthenticated, logout } = useAuth();
 
   const handleSearchChange = (e: React.ChangeEvent<HTMLInputElement>) => {
     const value = e.target.value;
@@ -55,18 +59,39 @@ const Header: React.FC<HeaderProps> = ({ onSearchChange, onMenuToggle, isMobileM
               </div>
             </div>
 
-            {/* Carrinho */}
-            <button
-              onClick={() => setIsCartOpen(true)}
-              className="relative p-2 text-gray-600 hover:text-blue-600 transition-colors"
-            >
-              <ShoppingCart size={24} />
-              {getTotalItems() > 0 && (
-                <span className="absolute -top-1 -right-1 bg-red-500 text-white text-xs rounded-full h-5 w-5 flex items-center justify-center">
-                  {getTotalItems()}
-                </span>
+            <div className="flex items-center">
+              {/* Sessão: a criação e edição de produtos exigem login */}
+              {isAuthenticated ? (
+                <button
+                  onClick={() => logout()}
+                  title="Sair"
+                  className="p-2 text-gray-600 hover:text-blue-600 transition-colors"
+                >
+                  <LogOut size={24} />
+                </button>
+              ) : (
+                <button
+                  onClick={() => setIsLoginOpen(true)}
+                  title="Entrar"
+                  className="p-2 text-gray-600 hover:text-blue-600 transition-colors"
+                >
+                  <LogIn size={24} />
+                </button>
               )}
-            </button>
+
+              {/* Carrinho */}
+              <button
+                onClick={() => setIsCartOpen(true)}
+                className="relative p-2 text-gray-600 hover:text-blue-600 transition-colors"
+              >
+                <ShoppingCart size={24} />
+                {getTotalItems() > 0 && (
+                  <span className="absolute -top-1 -right-1 bg-red-500 text-white text-xs rounded-full h-5 w-5 flex items-center justify-center">
+                    {getTotalItems()}
+                  </span>
+                )}
+              </button>
+            </div>
           </div>
 
           {/* Barra de Pesquisa Mobile */}
@@ -88,6 +113,7 @@ const Header: React.FC<HeaderProps> = ({ onSearchChange, onMenuToggle, isMobileM
       </header>
 
       <Cart isOpen={isCartOpen} onClose={() => setIsCartOpen(false)} />
+      <LoginModal isOpen={isLoginOpen} onClose={() => setIsLoginOpen(false)} />
     </>
   );
 };
@@ -0,0 +1,35 @@
+import React, { createContext, useContext, useEffect, useState } from 'react';
+import { apiClient } from '../infrastructure/api/ApiClient';
+
+interface AuthContextType {
+  isAuthenticated: boolean;
+  login: (email: string, password: string) => Promise<void>;
+  logout: () => Promise<void>;
+}
+
+const AuthContext = createContext<AuthContextType | undefined>(undefined);
+
+export const AuthProvider: React.FC<{ children: React.ReactNode }> = ({ children }) => {
+  const [isAuthenticated, setIsAuthenticated] = useState(() => apiClient.isAuthenticated());
+
+  // Acompanhar a sessão, que também termina quando a renovação dos tokens falha
+  useEffect(() => apiClient.onSessionChange(setIsAuthenticated), []);
+
+  const login = (email: string, password: string) => apiClient.login(email, password);
+
+  const logout = () => apiClient.clearTokens();
+
+  return (
+    <AuthContext.Provider value={{ isAuthenticated, login, logout }}>
+      {children}
+    </AuthContext.Provider>
+  );
+};
+
+export const useAuth = () => {
+  const context = useContext(AuthContext);
+  if (context === undefined) {
+    throw new Error('useAuth must be used within an AuthProvider');
+  }
+  return context;
+};
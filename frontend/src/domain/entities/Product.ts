@@ -6,6 +6,8 @@ export interface Product {
   image: string;
   category: string;
   description: string;
+  // Versão lida da API, exigida para atualizar ou remover o produto
+  version?: number;
 }
 
 // Entidade de domínio para CartItem
@@ -17,4 +19,6 @@ export interface CartItem extends Product {
 export interface Category {
   id: number;
   name: string;
+  // Versão lida da API, exigida para atualizar ou remover a categoria
+  version?: number;
 } 
\ No newline at end of file
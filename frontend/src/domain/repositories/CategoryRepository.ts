@@ -6,6 +6,7 @@ export interface CategoryRepository {
   getCategory(id: number): Promise<Category>;
   getCategoryByName(name: string): Promise<Category | null>;
   createCategory(name: string): Promise<Category>;
-  updateCategory(id: number, name: string): Promise<Category>;
-  deleteCategory(id: number): Promise<void>;
+  // version é a versão lida da categoria; a API recusa a escrita se ela mudou desde então
+  updateCategory(id: number, name: string, version: number): Promise<Category>;
+  deleteCategory(id: number, version: number): Promise<void>;
 } 
\ No newline at end of file
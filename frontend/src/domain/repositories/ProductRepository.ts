@@ -10,6 +10,7 @@ export interface ProductRepository {
   getProducts(filters?: ProductFilter): Promise<Product[]>;
   getProduct(id: number): Promise<Product>;
   createProduct(product: Omit<Product, 'id'>): Promise<Product>;
-  updateProduct(id: number, product: Partial<Product>): Promise<Product>;
-  deleteProduct(id: number): Promise<void>;
+  // version é a versão lida do produto; a API recusa a escrita se ele mudou desde então
+  updateProduct(id: number, product: Partial<Product>, version: number): Promise<Product>;
+  deleteProduct(id: number, version: number): Promise<void>;
 } 
\ No newline at end of file
@@ -7,8 +7,8 @@ export interface CategoryUseCase {
   getCategory(id: number): Promise<Category>;
   getCategoryByName(name: string): Promise<Category | null>;
   createCategory(name: string): Promise<Category>;
-  updateCategory(id: number, name: string): Promise<Category>;
-  deleteCategory(id: number): Promise<void>;
+  updateCategory(id: number, name: string, version: number): Promise<Category>;
+  deleteCategory(id: number, version: number): Promise<void>;
 }
 
 // Implementação dos casos de uso
@@ -59,7 +59,7 @@ export class CategoryUseCaseImpl implements CategoryUseCase {
     }
   }
 
-  async updateCategory(id: number, name: string): Promise<Category> {
+  async updateCategory(id: number, name: string, version: number): Promise<Category> {
     try {
       // Validações de negócio
       if (!name || name.trim().length === 0) {
@@ -69,16 +69,16 @@ export class CategoryUseCaseImpl implements CategoryUseCase {
         throw new Error('Nome da categoria deve ter pelo menos 2 caracteres');
       }
 
-      return await this.categoryRepository.updateCategory(id, name.trim());
+      return await this.categoryRepository.updateCategory(id, name.trim(), version);
     } catch (error) {
       console.error('Erro ao atualizar categoria:', error);
       throw error;
     }
   }
 
-  async deleteCategory(id: number): Promise<void> {
+  async deleteCategory(id: number, version: number): Promise<void> {
     try {
-      await this.categoryRepository.deleteCategory(id);
+      await this.categoryRepository.deleteCategory(id, version);
     } catch (error) {
       console.error('Erro ao deletar categoria:', error);
       throw new Error('Falha ao deletar categoria');
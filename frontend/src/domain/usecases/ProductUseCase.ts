@@ -6,8 +6,8 @@ export interface ProductUseCase {
   getProducts(filters?: ProductFilter): Promise<Product[]>;
   getProduct(id: number): Promise<Product>;
   createProduct(product: Omit<Product, 'id'>): Promise<Product>;
-  updateProduct(id: number, product: Partial<Product>): Promise<Product>;
-  deleteProduct(id: number): Promise<void>;
+  updateProduct(id: number, product: Partial<Product>, version: number): Promise<Product>;
+  deleteProduct(id: number, version: number): Promise<void>;
   searchProducts(query: string): Promise<Product[]>;
   filterProductsByCategory(category: string): Promise<Product[]>;
 }
@@ -51,7 +51,7 @@ export class ProductUseCaseImpl implements ProductUseCase {
     }
   }
 
-  async updateProduct(id: number, product: Partial<Product>): Promise<Product> {
+  async updateProduct(id: number, product: Partial<Product>, version: number): Promise<Product> {
     try {
       // Validações de negócio
       if (product.name !== undefined && product.name.trim().length === 0) {
@@ -61,16 +61,16 @@ export class ProductUseCaseImpl implements ProductUseCase {
         throw new Error('Preço deve ser maior que zero');
       }
 
-      return await this.productRepository.updateProduct(id, product);
+      return await this.productRepository.updateProduct(id, product, version);
     } catch (error) {
       console.error('Erro ao atualizar produto:', error);
       throw error;
     }
   }
 
-  async deleteProduct(id: number): Promise<void> {
+  async deleteProduct(id: number, version: number): Promise<void> {
     try {
-      await this.productRepository.deleteProduct(id);
+      await this.productRepository.deleteProduct(id, version);
     } catch (error) {
       console.error('Erro ao deletar produto:', error);
       throw new Error('Falha ao deletar produto');
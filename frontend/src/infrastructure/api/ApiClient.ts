@@ -1,10 +1,24 @@
-import axios, { AxiosInstance, AxiosResponse } from 'axios';
+import axios, { AxiosInstance, AxiosResponse, InternalAxiosRequestConfig } from 'axios';
+
+// Chaves dos tokens no localStorage
+const ACCESS_TOKEN_KEY = 'catalogo.accessToken';
+const REFRESH_TOKEN_KEY = 'catalogo.refreshToken';
+
+interface TokenResponse {
+  access_token: string;
+  refresh_token: string;
+}
 
 // Cliente da API
 export class ApiClient {
   private client: AxiosInstance;
+  // Renovação em andamento, compartilhada pelas requisições que receberem 401 ao mesmo tempo:
+  // cada refresh token só pode ser usado uma vez
+  private refreshing: Promise<string | null> | null = null;
+  // Ouvintes avisados quando a sessão começa ou termina
+  private sessionListeners = new Set<(authenticated: boolean) => void>();
 
-  constructor(baseURL: string = 'http://localhost:8080/api') {
+  constructor(baseURL: string = 'http://localhost:8080/api/v1') {
     this.client = axios.create({
       baseURL,
       timeout: 10000,
@@ -21,6 +35,10 @@ export class ApiClient {
     this.client.interceptors.request.use(
       (config) => {
         console.log('API Request:', config.method?.toUpperCase(), config.url);
+        const accessToken = localStorage.getItem(ACCESS_TOKEN_KEY);
+        if (accessToken) {
+          config.headers.Authorization = `Bearer ${accessToken}`;
+        }
         return config;
       },
       (error) => {
@@ -34,13 +52,90 @@ export class ApiClient {
         console.log('API Response:', response.status, response.config.url);
         return response;
       },
-      (error) => {
+      async (error) => {
         console.error('API Response Error:', error.response?.status, error.response?.data);
+
+        // Token de acesso expirado: renovar uma vez e repetir a requisição
+        const config = error.config as (InternalAxiosRequestConfig & { retried?: boolean }) | undefined;
+        if (error.response?.status === 401 && config && !config.retried && !config.url?.startsWith('/auth/')) {
+          config.retried = true;
+          const accessToken = await this.refreshTokens();
+          if (accessToken) {
+            config.headers.Authorization = `Bearer ${accessToken}`;
+            return this.client.request(config);
+          }
+        }
         return Promise.reject(error);
       }
     );
   }
 
+  // Entra com e-mail e senha e guarda os tokens da sessão
+  async login(email: string, password: string): Promise<void> {
+    const response = await this.client.post<TokenResponse>('/auth/login', { email, password });
+    this.setTokens(response.data.access_token, response.data.refresh_token);
+  }
+
+  // Indica se há uma sessão iniciada
+  isAuthenticated(): boolean {
+    return localStorage.getItem(REFRESH_TOKEN_KEY) !== null;
+  }
+
+  // Registra um ouvinte da sessão; retorna a função que o remove
+  onSessionChange(listener: (authenticated: boolean) => void): () => void {
+    this.sessionListeners.add(listener);
+    return () => {
+      this.sessionListeners.delete(listener);
+    };
+  }
+
+  // Guarda os tokens emitidos pela API
+  setTokens(accessToken: string, refreshToken: string): void {
+    localStorage.setItem(ACCESS_TOKEN_KEY, accessToken);
+    localStorage.setItem(REFRESH_TOKEN_KEY, refreshToken);
+    this.notifySession(true);
+  }
+
+  // Descarta os tokens, revogando a sessão na API
+  async clearTokens(): Promise<void> {
+    const refreshToken = localStorage.getItem(REFRESH_TOKEN_KEY);
+    localStorage.removeItem(ACCESS_TOKEN_KEY);
+    localStorage.removeItem(REFRESH_TOKEN_KEY);
+    this.notifySession(false);
+    if (refreshToken) {
+      await this.client.post('/auth/revoke', { refresh_token: refreshToken }).catch(() => undefined);
+    }
+  }
+
+  // Troca o refresh token por um novo par de tokens; retorna null se a sessão acabou
+  private refreshTokens(): Promise<string | null> {
+    if (!this.refreshing) {
+      this.refreshing = (async () => {
+        const refreshToken = localStorage.getItem(REFRESH_TOKEN_KEY);
+        if (!refreshToken) {
+          return null;
+        }
+        try {
+          const response = await this.client.post<TokenResponse>('/auth/refresh', { refresh_token: refreshToken });
+          this.setTokens(response.data.access_token, response.data.refresh_token);
+          return response.data.access_token;
+        } catch {
+          localStorage.removeItem(ACCESS_TOKEN_KEY);
+          localStorage.removeItem(REFRESH_TOKEN_KEY);
+          this.notifySession(false);
+          return null;
+        }
+      })().finally(() => {
+        this.refreshing = null;
+      });
+    }
+    return this.refreshing;
+  }
+
+  private notifySession(authenticated: boolean): void {
+    this.sessionListeners.forEach((listener) => listener(authenticated));
+  }
+
   // Métodos genéricos para requisições
   async get<T>(url: string, params?: any): Promise<T> {
     const response: AxiosResponse<T> = await this.client.get(url, { params });
@@ -52,16 +147,24 @@ export class ApiClient {
     return response.data;
   }
 
-  async put<T>(url: string, data?: any): Promise<T> {
-    const response: AxiosResponse<T> = await this.client.put(url, data);
+  // A API exige If-Match com a ETag lida: se o recurso mudou desde a leitura, a escrita é recusada (412)
+  async put<T>(url: string, data: any, etag: string): Promise<T> {
+    const response: AxiosResponse<T> = await this.client.put(url, data, {
+      headers: { 'If-Match': etag },
+    });
     return response.data;
   }
 
-  async delete<T>(url: string): Promise<T> {
-    const response: AxiosResponse<T> = await this.client.delete(url);
+  async delete<T>(url: string, etag: string): Promise<T> {
+    const response: AxiosResponse<T> = await this.client.delete(url, {
+      headers: { 'If-Match': etag },
+    });
     return response.data;
   }
 }
 
+// ETag correspondente à versão de um recurso, no formato da API ("v3")
+export const versionETag = (version: number): string => `"v${version}"`;
+
 // Instância singleton do cliente da API
 export const apiClient = new ApiClient(); 
\ No newline at end of file
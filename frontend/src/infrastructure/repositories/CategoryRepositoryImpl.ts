@@ -1,11 +1,12 @@
 import { Category } from '../../domain/entities/Product';
 import { CategoryRepository } from '../../domain/repositories/CategoryRepository';
-import { apiClient } from '../api/ApiClient';
+import { apiClient, versionETag } from '../api/ApiClient';
 
 // DTOs para comunicação com a API
 interface ApiCategory {
   id: number;
   name: string;
+  version: number;
   created_at: string;
   updated_at: string;
 }
@@ -35,6 +36,7 @@ export class CategoryRepositoryImpl implements CategoryRepository {
       return {
         id: response.data.id,
         name: response.data.name,
+        version: response.data.version,
       };
     } catch (error) {
       console.error('Erro ao buscar categoria:', error);
@@ -47,7 +49,7 @@ export class CategoryRepositoryImpl implements CategoryRepository {
     try {
       const categories = await apiClient.get<ApiResponse<ApiCategory[]>>('/categories');
       const category = categories.data.find(cat => cat.name === name);
-      return category ? { id: category.id, name: category.name } : null;
+      return category ? { id: category.id, name: category.name, version: category.version } : null;
     } catch (error) {
       console.error('Erro ao buscar categoria por nome:', error);
       throw error;
@@ -61,6 +63,7 @@ export class CategoryRepositoryImpl implements CategoryRepository {
       return {
         id: response.data.id,
         name: response.data.name,
+        version: response.data.version,
       };
     } catch (error) {
       console.error('Erro ao criar categoria:', error);
@@ -69,12 +72,13 @@ export class CategoryRepositoryImpl implements CategoryRepository {
   }
 
   // Atualizar categoria
-  async updateCategory(id: number, name: string): Promise<Category> {
+  async updateCategory(id: number, name: string, version: number): Promise<Category> {
     try {
-      const response = await apiClient.put<ApiResponse<ApiCategory>>(`/categories/${id}`, { name });
+      const response = await apiClient.put<ApiResponse<ApiCategory>>(`/categories/${id}`, { name }, versionETag(version));
       return {
         id: response.data.id,
         name: response.data.name,
+        version: response.data.version,
       };
     } catch (error) {
       console.error('Erro ao atualizar categoria:', error);
@@ -83,9 +87,9 @@ export class CategoryRepositoryImpl implements CategoryRepository {
   }
 
   // Deletar categoria
-  async deleteCategory(id: number): Promise<void> {
+  async deleteCategory(id: number, version: number): Promise<void> {
     try {
-      await apiClient.delete(`/categories/${id}`);
+      await apiClient.delete(`/categories/${id}`, versionETag(version));
     } catch (error) {
       console.error('Erro ao deletar categoria:', error);
       throw error;
@@ -1,6 +1,6 @@
 import { Product } from '../../domain/entities/Product';
 import { ProductRepository, ProductFilter } from '../../domain/repositories/ProductRepository';
-import { apiClient } from '../api/ApiClient';
+import { apiClient, versionETag } from '../api/ApiClient';
 
 // DTOs para comunicação com a API
 interface ApiProduct {
@@ -14,6 +14,7 @@ interface ApiProduct {
     name: string;
   };
   description: string;
+  version: number;
   created_at: string;
   updated_at: string;
 }
@@ -34,6 +35,7 @@ export class ProductRepositoryImpl implements ProductRepository {
       image: apiProduct.image,
       category: apiProduct.category.name,
       description: apiProduct.description,
+      version: apiProduct.version,
     };
   }
 
@@ -81,7 +83,7 @@ export class ProductRepositoryImpl implements ProductRepository {
   }
 
   // Atualizar produto
-  async updateProduct(id: number, product: Partial<Product>): Promise<Product> {
+  async updateProduct(id: number, product: Partial<Product>, version: number): Promise<Product> {
     try {
       const response = await apiClient.put<ApiResponse<ApiProduct>>(`/products/${id}`, {
         name: product.name,
@@ -89,7 +91,7 @@ export class ProductRepositoryImpl implements ProductRepository {
         image: product.image,
         description: product.description,
         category_id: 1, // TODO: Implementar busca de categoria por nome
-      });
+      }, versionETag(version));
       return this.mapApiProductToProduct(response.data);
     } catch (error) {
       console.error('Erro ao atualizar produto:', error);
@@ -98,9 +100,9 @@ export class ProductRepositoryImpl implements ProductRepository {
   }
 
   // Deletar produto
-  async deleteProduct(id: number): Promise<void> {
+  async deleteProduct(id: number, version: number): Promise<void> {
     try {
-      await apiClient.delete(`/products/${id}`);
+      await apiClient.delete(`/products/${id}`, versionETag(version));
     } catch (error) {
       console.error('Erro ao deletar produto:', error);
       throw error;
@@ -22,8 +22,9 @@ export const useUpdateProduct = (productUseCase: ProductUseCase) => {
   const queryClient = useQueryClient();
 
   return useMutation({
-    mutationFn: ({ id, product }: { id: number; product: Partial<Product> }) =>
-      productUseCase.updateProduct(id, product),
+    // version é a versão do produto exibido; se outra pessoa o alterou, a API responde 412
+    mutationFn: ({ id, product, version }: { id: number; product: Partial<Product>; version: number }) =>
+      productUseCase.updateProduct(id, product, version),
     onSuccess: (updatedProduct) => {
       // Invalidar cache de produtos
       queryClient.invalidateQueries({ queryKey: productKeys.lists() });
@@ -40,12 +41,12 @@ export const useDeleteProduct = (productUseCase: ProductUseCase) => {
   const queryClient = useQueryClient();
 
   return useMutation({
-    mutationFn: (id: number) => productUseCase.deleteProduct(id),
-    onSuccess: (_, deletedId) => {
+    mutationFn: ({ id, version }: { id: number; version: number }) => productUseCase.deleteProduct(id, version),
+    onSuccess: (_, { id }) => {
       // Invalidar cache de produtos
       queryClient.invalidateQueries({ queryKey: productKeys.lists() });
       // Remover produto específico do cache
-      queryClient.removeQueries({ queryKey: productKeys.detail(deletedId.toString()) });
+      queryClient.removeQueries({ queryKey: productKeys.detail(id.toString()) });
     },
     onError: (error) => {
       console.error('Erro ao deletar produto:', error);